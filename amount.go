@@ -0,0 +1,138 @@
+package ebay
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// Amount represents a monetary value as returned by the eBay API.
+//
+// Currency is the three-letter ISO 4217 code representing the currency.
+type Amount struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+// Decimal parses the value of the amount.
+// An empty value is parsed as zero.
+func (a Amount) Decimal() (Decimal, error) {
+	if a.Value == "" {
+		return Decimal{}, nil
+	}
+	return ParseDecimal(a.Value)
+}
+
+// IsZero reports whether the amount is unset.
+func (a Amount) IsZero() bool {
+	return a.Value == "" && a.Currency == ""
+}
+
+// currencyMinorUnits lists the ISO 4217 currencies whose minor unit is not 2 decimal places.
+var currencyMinorUnits = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+}
+
+// CurrencyMinorUnit returns the number of decimal places of the minor unit of currency,
+// for example 2 for USD and 0 for JPY.
+func CurrencyMinorUnit(currency string) int {
+	if places, ok := currencyMinorUnits[currency]; ok {
+		return places
+	}
+	return 2
+}
+
+// Decimal is an exact decimal number used for monetary computations.
+// The zero value is 0 and is ready to use.
+type Decimal struct {
+	r *big.Rat
+}
+
+var decimalRegexp = regexp.MustCompile(`^[-+]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+// ParseDecimal parses a decimal string such as "12.34".
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalRegexp.MatchString(s) {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	return Decimal{r}, nil
+}
+
+// NewDecimal returns the decimal value of i.
+func NewDecimal(i int64) Decimal {
+	return Decimal{new(big.Rat).SetInt64(i)}
+}
+
+func (d Decimal) rat() *big.Rat {
+	if d.r == nil {
+		return new(big.Rat)
+	}
+	return d.r
+}
+
+// Add returns d+x.
+func (d Decimal) Add(x Decimal) Decimal {
+	return Decimal{new(big.Rat).Add(d.rat(), x.rat())}
+}
+
+// Sub returns d-x.
+func (d Decimal) Sub(x Decimal) Decimal {
+	return Decimal{new(big.Rat).Sub(d.rat(), x.rat())}
+}
+
+// Mul returns d*x.
+func (d Decimal) Mul(x Decimal) Decimal {
+	return Decimal{new(big.Rat).Mul(d.rat(), x.rat())}
+}
+
+// Cmp compares d and x and returns -1, 0 or +1.
+func (d Decimal) Cmp(x Decimal) int {
+	return d.rat().Cmp(x.rat())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.rat().Sign()
+}
+
+// Round returns d rounded to the given number of decimal places.
+// Halves are rounded away from zero.
+func (d Decimal) Round(places int) Decimal {
+	r, _ := new(big.Rat).SetString(d.rat().FloatString(places))
+	return Decimal{r}
+}
+
+// StringFixed returns d rounded to the given number of decimal places.
+// Halves are rounded away from zero.
+func (d Decimal) StringFixed(places int) string {
+	return d.rat().FloatString(places)
+}
+
+// String returns the decimal representation of d without trailing zeros.
+// Values with more than 18 decimal places are rounded.
+func (d Decimal) String() string {
+	s := d.rat().FloatString(18)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// MarshalText allows Decimal to be encoded as a JSON string.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText allows Decimal to be decoded from a JSON string.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,50 @@
+package ebay_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	d, err := ebay.ParseDecimal("12.50")
+	assert.Nil(t, err)
+	assert.Equal(t, "12.5", d.String())
+	for _, s := range []string{"", "1/3", "1e3", "abc", "1.2.3"} {
+		_, err := ebay.ParseDecimal(s)
+		assert.NotNil(t, err, s)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, _ := ebay.ParseDecimal("0.1")
+	b, _ := ebay.ParseDecimal("0.2")
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.Equal(t, "0", ebay.Decimal{}.String())
+	assert.Equal(t, "1.10", ebay.NewDecimal(11).Mul(a).StringFixed(2))
+	c, _ := ebay.ParseDecimal("1.005")
+	assert.Equal(t, "1.01", c.Round(2).String())
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct{ D ebay.Decimal }
+	assert.Nil(t, json.Unmarshal([]byte(`{"D":"3.14"}`), &v))
+	assert.Equal(t, "3.14", v.D.String())
+	b, err := json.Marshal(v)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"D":"3.14"}`, string(b))
+}
+
+func TestAmountDecimal(t *testing.T) {
+	d, err := ebay.Amount{Value: "9.99", Currency: "USD"}.Decimal()
+	assert.Nil(t, err)
+	assert.Equal(t, "9.99", d.String())
+	d, err = ebay.Amount{}.Decimal()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, d.Sign())
+}
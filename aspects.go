@@ -0,0 +1,252 @@
+package ebay
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CategoryAspects represents the aspects of a leaf category.
+type CategoryAspects struct {
+	Category Category         `json:"category"`
+	Aspects  []CategoryAspect `json:"aspects"`
+}
+
+// ItemAspects represents the aspects of every leaf category of a category tree.
+type ItemAspects struct {
+	CategoryTreeID      string            `json:"categoryTreeId"`
+	CategoryTreeVersion string            `json:"categoryTreeVersion"`
+	CategoryAspects     []CategoryAspects `json:"categoryAspects"`
+}
+
+// FetchItemAspects retrieves the aspects of every leaf category of a category tree.
+// eBay serves the aspects as a gzipped JSON file which is decompressed while decoding.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/fetchItemAspects
+func (s *TaxonomyService) FetchItemAspects(ctx context.Context, categoryTreeID string, opts ...Opt) (ItemAspects, error) {
+	u := fmt.Sprintf("commerce/taxonomy/v1/category_tree/%s/fetch_item_aspects", categoryTreeID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return ItemAspects{}, err
+	}
+	resp, err := s.client.do(ctx, req)
+	if err != nil {
+		return ItemAspects{}, err
+	}
+	defer resp.Body.Close()
+	var r io.Reader = bufio.NewReader(resp.Body)
+	// The body is not gzipped if the transport already decompressed it.
+	if magic, _ := r.(*bufio.Reader).Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return ItemAspects{}, errors.WithStack(err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+	var aspects ItemAspects
+	return aspects, errors.WithStack(json.NewDecoder(r).Decode(&aspects))
+}
+
+// AspectError describes an aspect, or an aspect value, that does not match the metadata of a category.
+type AspectError struct {
+	Aspect  string
+	Value   string
+	Message string
+}
+
+func (e *AspectError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("aspect %q: %s", e.Aspect, e.Message)
+	}
+	return fmt.Sprintf("aspect %q value %q: %s", e.Aspect, e.Value, e.Message)
+}
+
+// AspectErrors lists every aspect that does not match the metadata of a category.
+type AspectErrors []*AspectError
+
+func (e AspectErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ParseAspectFilter parses a filter as used by OptBrowseSearchAspectFilter,
+// for example "categoryId:15724,Color:{Red|Blue}".
+func ParseAspectFilter(filter string) (categoryID string, values map[string][]string, err error) {
+	values = map[string][]string{}
+	for _, part := range splitAspectFilter(filter) {
+		sep := strings.Index(part, ":")
+		if sep < 0 {
+			return "", nil, errors.Errorf("invalid aspect filter %q: missing ':' in %q", filter, part)
+		}
+		name, value := strings.TrimSpace(part[:sep]), strings.TrimSpace(part[sep+1:])
+		if name == "categoryId" {
+			categoryID = value
+			continue
+		}
+		if !strings.HasPrefix(value, "{") || !strings.HasSuffix(value, "}") {
+			return "", nil, errors.Errorf("invalid aspect filter %q: values of %q must be enclosed in braces", filter, name)
+		}
+		values[name] = append(values[name], strings.Split(value[1:len(value)-1], "|")...)
+	}
+	if categoryID == "" {
+		return "", nil, errors.Errorf("invalid aspect filter %q: missing categoryId", filter)
+	}
+	return categoryID, values, nil
+}
+
+// splitAspectFilter splits a filter on the commas outside of braces.
+func splitAspectFilter(filter string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range filter {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, filter[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, filter[start:])
+}
+
+// ValidateAspectFilter checks an aspect filter against the aspects of its category.
+// Unknown aspects and values not allowed by SELECTION_ONLY aspects are reported as AspectErrors.
+func ValidateAspectFilter(filter string, aspects []CategoryAspect) error {
+	_, values, err := ParseAspectFilter(filter)
+	if err != nil {
+		return err
+	}
+	var errs AspectErrors
+	byName := aspectsByName(aspects)
+	for name, vals := range values {
+		aspect, ok := byName[name]
+		if !ok {
+			errs = append(errs, &AspectError{Aspect: name, Message: "unknown aspect"})
+			continue
+		}
+		for _, v := range vals {
+			if !aspect.allows(v) {
+				errs = append(errs, &AspectError{Aspect: name, Value: v, Message: "value not allowed"})
+			}
+		}
+	}
+	return errs.sorted()
+}
+
+// ValidateListingAspects checks the aspects of a listing against the aspects of its category.
+// Unknown aspects, missing required aspects, too many values, values too long, values not allowed
+// by SELECTION_ONLY aspects and values that do not apply to the other aspects of the listing
+// are reported as AspectErrors.
+func ValidateListingAspects(values map[string][]string, aspects []CategoryAspect) error {
+	var errs AspectErrors
+	byName := aspectsByName(aspects)
+	for name := range values {
+		if _, ok := byName[name]; !ok {
+			errs = append(errs, &AspectError{Aspect: name, Message: "unknown aspect"})
+		}
+	}
+	for _, aspect := range aspects {
+		name := aspect.LocalizedAspectName
+		constraint := aspect.AspectConstraint
+		vals := values[name]
+		if len(vals) == 0 {
+			if constraint.AspectRequired {
+				errs = append(errs, &AspectError{Aspect: name, Message: "required aspect missing"})
+			}
+			continue
+		}
+		if constraint.ItemToAspectCardinality == AspectCardinalitySingle && len(vals) > 1 {
+			errs = append(errs, &AspectError{Aspect: name, Message: fmt.Sprintf("single value aspect has %d values", len(vals))})
+		}
+		for _, v := range vals {
+			if constraint.AspectMaxLength > 0 && len([]rune(v)) > constraint.AspectMaxLength {
+				errs = append(errs, &AspectError{Aspect: name, Value: v,
+					Message: fmt.Sprintf("value longer than %d characters", constraint.AspectMaxLength)})
+			}
+			if !aspect.allows(v) {
+				errs = append(errs, &AspectError{Aspect: name, Value: v, Message: "value not allowed"})
+				continue
+			}
+			if msg := aspect.checkValueConstraints(v, values); msg != "" {
+				errs = append(errs, &AspectError{Aspect: name, Value: v, Message: msg})
+			}
+		}
+	}
+	return errs.sorted()
+}
+
+func aspectsByName(aspects []CategoryAspect) map[string]*CategoryAspect {
+	byName := make(map[string]*CategoryAspect, len(aspects))
+	for i := range aspects {
+		byName[aspects[i].LocalizedAspectName] = &aspects[i]
+	}
+	return byName
+}
+
+// allows reports whether v is a valid value of the aspect.
+func (a *CategoryAspect) allows(v string) bool {
+	if a.AspectConstraint.AspectMode != AspectModeSelectionOnly {
+		return true
+	}
+	for _, av := range a.AspectValues {
+		if av.LocalizedValue == v {
+			return true
+		}
+	}
+	return false
+}
+
+// checkValueConstraints returns why v does not apply to the other aspects of a listing,
+// or an empty string if it does.
+func (a *CategoryAspect) checkValueConstraints(v string, values map[string][]string) string {
+	for _, av := range a.AspectValues {
+		if av.LocalizedValue != v {
+			continue
+		}
+		for _, c := range av.ValueConstraints {
+			other := values[c.ApplicableForLocalizedAspectName]
+			if len(other) == 0 {
+				continue
+			}
+			applies := false
+			for _, o := range other {
+				if contains(c.ApplicableForLocalizedAspectValues, o) {
+					applies = true
+				}
+			}
+			if !applies {
+				return fmt.Sprintf("value only applies when %q is one of %v",
+					c.ApplicableForLocalizedAspectName, c.ApplicableForLocalizedAspectValues)
+			}
+		}
+	}
+	return ""
+}
+
+// sorted returns the errors sorted by aspect, or nil if there are none.
+func (e AspectErrors) sorted() error {
+	if len(e) == 0 {
+		return nil
+	}
+	sort.SliceStable(e, func(i, j int) bool {
+		return e[i].Aspect < e[j].Aspect
+	})
+	return e
+}
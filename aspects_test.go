@@ -0,0 +1,101 @@
+package ebay_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+const categoryAspectsJSON = `[
+	{"localizedAspectName": "Make",
+		"aspectConstraint": {"aspectMode": "SELECTION_ONLY", "aspectRequired": true, "itemToAspectCardinality": "SINGLE"},
+		"aspectValues": [{"localizedValue": "Toyota"}, {"localizedValue": "Honda"}]},
+	{"localizedAspectName": "Model",
+		"aspectConstraint": {"aspectMode": "SELECTION_ONLY", "itemToAspectCardinality": "SINGLE"},
+		"aspectValues": [
+			{"localizedValue": "Camry", "valueConstraints": [{"applicableForLocalizedAspectName": "Make", "applicableForLocalizedAspectValues": ["Toyota"]}]},
+			{"localizedValue": "Civic", "valueConstraints": [{"applicableForLocalizedAspectName": "Make", "applicableForLocalizedAspectValues": ["Honda"]}]}]},
+	{"localizedAspectName": "Color",
+		"aspectConstraint": {"aspectMode": "FREE_TEXT", "aspectMaxLength": 5, "itemToAspectCardinality": "MULTI"}}
+]`
+
+func categoryAspects(t *testing.T) []ebay.CategoryAspect {
+	var aspects []ebay.CategoryAspect
+	if err := json.Unmarshal([]byte(categoryAspectsJSON), &aspects); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return aspects
+}
+
+func TestFetchItemAspects(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/taxonomy/v1/category_tree/0/fetch_item_aspects", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(`{"categoryTreeId": "0", "categoryTreeVersion": "119", "categoryAspects": [
+			{"category": {"categoryId": "6001", "categoryName": "Cars & Trucks"}, "aspects": ` + categoryAspectsJSON + `}]}`))
+		gz.Close()
+	})
+
+	aspects, err := client.Commerce.Taxonomy.FetchItemAspects(context.Background(), "0")
+	assert.Nil(t, err)
+	assert.Equal(t, "119", aspects.CategoryTreeVersion)
+	assert.Equal(t, "6001", aspects.CategoryAspects[0].Category.CategoryID)
+	assert.Len(t, aspects.CategoryAspects[0].Aspects, 3)
+}
+
+func TestParseAspectFilter(t *testing.T) {
+	categoryID, values, err := ebay.ParseAspectFilter("categoryId:6001,Make:{Toyota|Honda},Color:{Red, Blue}")
+	assert.Nil(t, err)
+	assert.Equal(t, "6001", categoryID)
+	assert.Equal(t, map[string][]string{"Make": {"Toyota", "Honda"}, "Color": {"Red, Blue"}}, values)
+
+	_, _, err = ebay.ParseAspectFilter("Make:{Toyota}")
+	assert.NotNil(t, err)
+	_, _, err = ebay.ParseAspectFilter("categoryId:6001,Make:Toyota")
+	assert.NotNil(t, err)
+}
+
+func TestValidateAspectFilter(t *testing.T) {
+	aspects := categoryAspects(t)
+	assert.Nil(t, ebay.ValidateAspectFilter("categoryId:6001,Make:{Toyota|Honda},Color:{Anything}", aspects))
+
+	err := ebay.ValidateAspectFilter("categoryId:6001,Make:{Toyota|Ford},Doors:{4}", aspects)
+	assert.Equal(t, ebay.AspectErrors{
+		{Aspect: "Doors", Message: "unknown aspect"},
+		{Aspect: "Make", Value: "Ford", Message: "value not allowed"},
+	}, err)
+	assert.Equal(t, `aspect "Doors": unknown aspect; aspect "Make" value "Ford": value not allowed`, err.Error())
+}
+
+func TestValidateListingAspects(t *testing.T) {
+	aspects := categoryAspects(t)
+	assert.Nil(t, ebay.ValidateListingAspects(map[string][]string{
+		"Make": {"Toyota"}, "Model": {"Camry"}, "Color": {"Red", "Blue"},
+	}, aspects))
+
+	err := ebay.ValidateListingAspects(map[string][]string{
+		"Model": {"Civic", "Camry"}, "Color": {"Burgundy"}, "Doors": {"4"},
+	}, aspects)
+	assert.Equal(t, ebay.AspectErrors{
+		{Aspect: "Color", Value: "Burgundy", Message: "value longer than 5 characters"},
+		{Aspect: "Doors", Message: "unknown aspect"},
+		{Aspect: "Make", Message: "required aspect missing"},
+		{Aspect: "Model", Message: "single value aspect has 2 values"},
+	}, err)
+
+	err = ebay.ValidateListingAspects(map[string][]string{"Make": {"Honda"}, "Model": {"Camry"}}, aspects)
+	assert.Equal(t, ebay.AspectErrors{
+		{Aspect: "Model", Value: "Camry", Message: `value only applies when "Make" is one of [Toyota]`},
+	}, err)
+}
@@ -0,0 +1,180 @@
+package ebay
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// BidIncrement represents the minimum bid increment applying to current prices greater than or equal to From.
+type BidIncrement struct {
+	From      Decimal
+	Increment Decimal
+}
+
+// BidIncrementTable lists bid increments sorted by ascending From.
+type BidIncrementTable []BidIncrement
+
+// Increment returns the minimum bid increment for the current price of an auction.
+func (t BidIncrementTable) Increment(price Decimal) Decimal {
+	i := sort.Search(len(t), func(i int) bool {
+		return t[i].From.Cmp(price) > 0
+	})
+	if i == 0 {
+		return Decimal{}
+	}
+	return t[i-1].Increment
+}
+
+// bidIncrements builds a BidIncrementTable from pairs of "from" and "increment" values.
+func bidIncrements(pairs ...string) BidIncrementTable {
+	var t BidIncrementTable
+	for i := 0; i+1 < len(pairs); i += 2 {
+		from, err := ParseDecimal(pairs[i])
+		if err != nil {
+			panic(err)
+		}
+		inc, err := ParseDecimal(pairs[i+1])
+		if err != nil {
+			panic(err)
+		}
+		t = append(t, BidIncrement{From: from, Increment: inc})
+	}
+	return t
+}
+
+var (
+	bidIncrementsUS = bidIncrements(
+		"0", "0.05", "1.00", "0.25", "5.00", "0.50", "25.00", "1.00", "100.00", "2.50",
+		"250.00", "5.00", "500.00", "10.00", "1000.00", "25.00", "2500.00", "50.00", "5000.00", "100.00")
+	bidIncrementsGB = bidIncrements(
+		"0", "0.05", "1.01", "0.20", "5.01", "0.50", "15.01", "1.00", "60.01", "2.00",
+		"150.01", "5.00", "300.01", "10.00", "600.01", "20.00", "1500.01", "50.00", "3000.01", "100.00")
+	bidIncrementsDE = bidIncrements(
+		"0", "0.50", "50.00", "1.00", "500.00", "5.00", "1000.00", "10.00", "5000.00", "50.00")
+)
+
+// BidIncrementTables maps marketplace IDs to approximate bid increment tables.
+// The US, GB and DE tables follow the bid increments of those marketplaces, the other marketplaces
+// reuse the table of a similar marketplace and may differ from the increments eBay applies.
+// Entries can be replaced with the tables of the eBay help pages of each marketplace.
+// ItemBidConstraints relies on the minimum bid computed by eBay instead.
+var BidIncrementTables = map[string]BidIncrementTable{
+	BuyMarketplaceAustralia:    bidIncrementsUS, // Approximation.
+	BuyMarketplaceCanada:       bidIncrementsUS, // Approximation.
+	BuyMarketplaceGermany:      bidIncrementsDE,
+	BuyMarketplaceSpain:        bidIncrementsDE, // Approximation.
+	BuyMarketplaceFrance:       bidIncrementsDE, // Approximation.
+	BuyMarketplaceGreatBritain: bidIncrementsGB,
+	BuyMarketplaceHongKong:     bidIncrementsUS, // Approximation.
+	BuyMarketplaceItalia:       bidIncrementsDE, // Approximation.
+	BuyMarketplaceUSA:          bidIncrementsUS,
+}
+
+// BidError describes a bid rejected without being sent to eBay.
+// Code is the PlaceProxyBid error code eBay would have returned, so that
+// IsError can be used indifferently on local and eBay errors.
+type BidError struct {
+	Code    int
+	Message string
+}
+
+func (e *BidError) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// ErrorCodes allows BidError to implement ErrorCoder.
+func (e *BidError) ErrorCodes() []int {
+	return []int{e.Code}
+}
+
+// BidConstraints describes the requirements a proxy bid on an auction must satisfy.
+type BidConstraints struct {
+	Currency   string
+	MinimumBid Decimal
+	// BuyItNowPrice is zero if the auction has no Buy It Now option.
+	BuyItNowPrice Decimal
+	// CurrentProxyBid is zero if the buyer has no proxy bid on the auction.
+	CurrentProxyBid Decimal
+}
+
+// BiddingBidConstraints computes the constraints of an auction from the buyer's bidding details.
+func BiddingBidConstraints(b Bidding, marketplaceID string) (BidConstraints, error) {
+	c := BidConstraints{Currency: b.CurrentPrice.Currency}
+	price, err := b.CurrentPrice.Decimal()
+	if err != nil {
+		return c, err
+	}
+	c.MinimumBid = price
+	if b.BidCount > 0 {
+		table, ok := BidIncrementTables[marketplaceID]
+		if !ok {
+			return c, fmt.Errorf("no bid increment table for marketplace %s", marketplaceID)
+		}
+		c.MinimumBid = price.Add(table.Increment(price))
+	}
+	if c.CurrentProxyBid, err = b.CurrentProxyBid.MaxAmount.Decimal(); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// ItemBidConstraints computes the constraints of an auction from the item returned by the Browse API.
+func ItemBidConstraints(it Item) (BidConstraints, error) {
+	c := BidConstraints{Currency: it.MinimumPriceToBid.Currency}
+	var err error
+	if c.MinimumBid, err = it.MinimumPriceToBid.Decimal(); err != nil {
+		return c, err
+	}
+	auction, fixedPrice := false, false
+	for _, opt := range it.BuyingOptions {
+		auction = auction || opt == BrowseBuyingOptionAuction
+		fixedPrice = fixedPrice || opt == BrowseBuyingOptionFixedPrice
+	}
+	if auction && fixedPrice {
+		if c.BuyItNowPrice, err = it.Price.Decimal(); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+var (
+	bidAmountRegexp   = regexp.MustCompile(`^[0-9]+(\.[0-9]{1,2})?$`)
+	bidCurrencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// Validate returns a *BidError if a proxy bid of maxAmount in currency would be rejected by eBay.
+// See OfferService.PlaceProxyBid for the format of maxAmount and currency.
+func (c BidConstraints) Validate(maxAmount, currency string) error {
+	if maxAmount == "" {
+		return &BidError{ErrPlaceProxyBidMaximumBidAmountMissing, "maximum bid amount is missing"}
+	}
+	if !bidAmountRegexp.MatchString(maxAmount) {
+		return &BidError{ErrPlaceProxyBidAmountInvalid, fmt.Sprintf("invalid amount %q", maxAmount)}
+	}
+	if !bidCurrencyRegexp.MatchString(currency) {
+		return &BidError{ErrPlaceProxyBidCurrencyInvalid, fmt.Sprintf("invalid currency %q", currency)}
+	}
+	if c.Currency != "" && currency != c.Currency {
+		return &BidError{ErrPlaceProxyBidCurrencyMustMatchItemPriceCurrency,
+			fmt.Sprintf("currency %s does not match item currency %s", currency, c.Currency)}
+	}
+	amount, err := ParseDecimal(maxAmount)
+	if err != nil {
+		return &BidError{ErrPlaceProxyBidAmountInvalid, err.Error()}
+	}
+	if c.CurrentProxyBid.Sign() > 0 && amount.Cmp(c.CurrentProxyBid) < 0 {
+		return &BidError{ErrPlaceProxyBidCannotLowerYourProxyBid,
+			fmt.Sprintf("amount %s is lower than the current proxy bid %s", maxAmount, c.CurrentProxyBid.StringFixed(2))}
+	}
+	if amount.Cmp(c.MinimumBid) < 0 {
+		return &BidError{ErrPlaceProxyBidAmountTooLow,
+			fmt.Sprintf("amount %s is lower than the minimum bid %s", maxAmount, c.MinimumBid.StringFixed(2))}
+	}
+	if c.BuyItNowPrice.Sign() > 0 && amount.Cmp(c.BuyItNowPrice) > 0 {
+		return &BidError{ErrPlaceProxyBidBidCannotBeGreaterThanBuyItNowPrice,
+			fmt.Sprintf("amount %s is greater than the Buy It Now price %s", maxAmount, c.BuyItNowPrice.StringFixed(2))}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package ebay_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBidIncrementTable(t *testing.T) {
+	table := ebay.BidIncrementTables[ebay.BuyMarketplaceUSA]
+	for price, inc := range map[string]string{
+		"0.50": "0.05", "1.00": "0.25", "24.99": "0.5", "25": "1", "6000": "100",
+	} {
+		p, _ := ebay.ParseDecimal(price)
+		assert.Equal(t, inc, table.Increment(p).String(), price)
+	}
+}
+
+func TestBiddingBidConstraints(t *testing.T) {
+	var b ebay.Bidding
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"currentPrice": {"value": "24.00", "currency": "USD"},
+		"bidCount": 3,
+		"currentProxyBid": {"maxAmount": {"value": "26.00", "currency": "USD"}}
+	}`), &b))
+	c, err := ebay.BiddingBidConstraints(b, ebay.BuyMarketplaceUSA)
+	assert.Nil(t, err)
+	assert.Equal(t, "24.5", c.MinimumBid.String())
+
+	assert.Nil(t, c.Validate("30.00", "USD"))
+	for amount, code := range map[string]int{
+		"":      ebay.ErrPlaceProxyBidMaximumBidAmountMissing,
+		"30.":   ebay.ErrPlaceProxyBidAmountInvalid,
+		"1.234": ebay.ErrPlaceProxyBidAmountInvalid,
+		"-1":    ebay.ErrPlaceProxyBidAmountInvalid,
+		"25":    ebay.ErrPlaceProxyBidCannotLowerYourProxyBid,
+	} {
+		err := c.Validate(amount, "USD")
+		assert.True(t, ebay.IsError(err, code), "%s: %v", amount, err)
+	}
+	assert.True(t, ebay.IsError(c.Validate("30", "usd"), ebay.ErrPlaceProxyBidCurrencyInvalid))
+	assert.True(t, ebay.IsError(c.Validate("30", "EUR"), ebay.ErrPlaceProxyBidCurrencyMustMatchItemPriceCurrency))
+
+	c.CurrentProxyBid = ebay.Decimal{}
+	assert.True(t, ebay.IsError(c.Validate("24.49", "USD"), ebay.ErrPlaceProxyBidAmountTooLow))
+}
+
+func TestItemBidConstraints(t *testing.T) {
+	var it ebay.Item
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"price": {"value": "100.00", "currency": "USD"},
+		"buyingOptions": ["AUCTION", "FIXED_PRICE"],
+		"minimumPriceToBid": {"value": "10.00", "currency": "USD"}
+	}`), &it))
+	c, err := ebay.ItemBidConstraints(it)
+	assert.Nil(t, err)
+	assert.Nil(t, c.Validate("10", "USD"))
+	assert.Nil(t, c.Validate("100.00", "USD"))
+	assert.True(t, ebay.IsError(c.Validate("9.99", "USD"), ebay.ErrPlaceProxyBidAmountTooLow))
+	assert.True(t, ebay.IsError(c.Validate("100.01", "USD"), ebay.ErrPlaceProxyBidBidCannotBeGreaterThanBuyItNowPrice))
+}
@@ -42,14 +42,11 @@ type LegacyItem struct {
 	SellerItemRevision string `json:"sellerItemRevision"`
 	Title              string `json:"title"`
 	ShortDescription   string `json:"shortDescription"`
-	Price              struct {
-		Value    string `json:"value"`
-		Currency string `json:"currency"`
-	} `json:"price"`
-	CategoryPath string `json:"categoryPath"`
-	Condition    string `json:"condition"`
-	ConditionID  string `json:"conditionId"`
-	ItemLocation struct {
+	Price              Amount `json:"price"`
+	CategoryPath       string `json:"categoryPath"`
+	Condition          string `json:"condition"`
+	ConditionID        string `json:"conditionId"`
+	ItemLocation       struct {
 		City            string `json:"city"`
 		StateOrProvince string `json:"stateOrProvince"`
 		PostalCode      string `json:"postalCode"`
@@ -76,14 +73,11 @@ type LegacyItem struct {
 		EstimatedSoldQuantity       int      `json:"estimatedSoldQuantity"`
 	} `json:"estimatedAvailabilities"`
 	ShippingOptions []struct {
-		ShippingServiceCode string `json:"shippingServiceCode"`
-		TrademarkSymbol     string `json:"trademarkSymbol"`
-		ShippingCarrierCode string `json:"shippingCarrierCode"`
-		Type                string `json:"type"`
-		ShippingCost        struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"shippingCost"`
+		ShippingServiceCode           string    `json:"shippingServiceCode"`
+		TrademarkSymbol               string    `json:"trademarkSymbol"`
+		ShippingCarrierCode           string    `json:"shippingCarrierCode"`
+		Type                          string    `json:"type"`
+		ShippingCost                  Amount    `json:"shippingCost"`
 		QuantityUsedForEstimate       int       `json:"quantityUsedForEstimate"`
 		MinEstimatedDeliveryDate      time.Time `json:"minEstimatedDeliveryDate"`
 		MaxEstimatedDeliveryDate      time.Time `json:"maxEstimatedDeliveryDate"`
@@ -91,11 +85,8 @@ type LegacyItem struct {
 			PostalCode string `json:"postalCode"`
 			Country    string `json:"country"`
 		} `json:"shipToLocationUsedForEstimate"`
-		AdditionalShippingCostPerUnit struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"additionalShippingCostPerUnit"`
-		ShippingCostType string `json:"shippingCostType"`
+		AdditionalShippingCostPerUnit Amount `json:"additionalShippingCostPerUnit"`
+		ShippingCostType              string `json:"shippingCostType"`
 	} `json:"shippingOptions"`
 	ShipToLocations struct {
 		RegionIncluded []struct {
@@ -171,12 +162,9 @@ func (s *BrowseService) GetItemByLegacyID(ctx context.Context, itemLegacyID stri
 
 // CompactItem represents the "COMPACT" version of an eBay item.
 type CompactItem struct {
-	ItemID             string `json:"itemId"`
-	SellerItemRevision string `json:"sellerItemRevision"`
-	Price              struct {
-		Value    string `json:"value"`
-		Currency string `json:"currency"`
-	} `json:"price"`
+	ItemID                  string `json:"itemId"`
+	SellerItemRevision      string `json:"sellerItemRevision"`
+	Price                   Amount `json:"price"`
 	EstimatedAvailabilities []struct {
 		AvailabilityThresholdType   string `json:"availabilityThresholdType"`
 		AvailabilityThreshold       int    `json:"availabilityThreshold"`
@@ -201,46 +189,26 @@ func (s *BrowseService) GetCompactItem(ctx context.Context, itemID string, opts
 
 // Item represents an eBay item.
 type Item struct {
-	ItemID             string `json:"itemId"`
-	SellerItemRevision string `json:"sellerItemRevision"`
-	Title              string `json:"title"`
-	Subtitle           string `json:"subtitle"`
-	ShortDescription   string `json:"shortDescription"`
-	Price              struct {
-		Value    string `json:"value"`
-		Currency string `json:"currency"`
-	} `json:"price"`
-	CategoryPath string `json:"categoryPath"`
-	Condition    string `json:"condition"`
-	ConditionID  string `json:"conditionId"`
-	ItemLocation struct {
-		City    string `json:"city"`
-		Country string `json:"country"`
-	} `json:"itemLocation"`
-	Image struct {
-		ImageURL string `json:"imageUrl"`
-	} `json:"image"`
-	AdditionalImages []struct {
-		ImageURL string `json:"imageUrl"`
-	} `json:"additionalImages"`
-	MarketingPrice struct {
-		OriginalPrice struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"originalPrice"`
+	ItemID             string       `json:"itemId"`
+	SellerItemRevision string       `json:"sellerItemRevision"`
+	Title              string       `json:"title"`
+	Subtitle           string       `json:"subtitle"`
+	ShortDescription   string       `json:"shortDescription"`
+	Price              Amount       `json:"price"`
+	CategoryPath       string       `json:"categoryPath"`
+	Condition          string       `json:"condition"`
+	ConditionID        string       `json:"conditionId"`
+	ItemLocation       ItemLocation `json:"itemLocation"`
+	Image              Image        `json:"image"`
+	AdditionalImages   []Image      `json:"additionalImages"`
+	MarketingPrice     struct {
+		OriginalPrice      Amount `json:"originalPrice"`
 		DiscountPercentage string `json:"discountPercentage"`
-		DiscountAmount     struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"discountAmount"`
+		DiscountAmount     Amount `json:"discountAmount"`
 	} `json:"marketingPrice"`
-	Color  string `json:"color"`
-	Brand  string `json:"brand"`
-	Seller struct {
-		Username           string `json:"username"`
-		FeedbackPercentage string `json:"feedbackPercentage"`
-		FeedbackScore      int    `json:"feedbackScore"`
-	} `json:"seller"`
+	Color                   string `json:"color"`
+	Brand                   string `json:"brand"`
+	Seller                  Seller `json:"seller"`
 	Gtin                    string `json:"gtin"`
 	Mpn                     string `json:"mpn"`
 	Epid                    string `json:"epid"`
@@ -252,32 +220,29 @@ type Item struct {
 		EstimatedSoldQuantity       int      `json:"estimatedSoldQuantity"`
 	} `json:"estimatedAvailabilities"`
 	ShippingOptions []struct {
-		ShippingServiceCode string `json:"shippingServiceCode"`
-		Type                string `json:"type"`
-		ShippingCost        struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"shippingCost"`
+		ShippingServiceCode           string    `json:"shippingServiceCode"`
+		Type                          string    `json:"type"`
+		ShippingCost                  Amount    `json:"shippingCost"`
 		QuantityUsedForEstimate       int       `json:"quantityUsedForEstimate"`
 		MinEstimatedDeliveryDate      time.Time `json:"minEstimatedDeliveryDate"`
 		MaxEstimatedDeliveryDate      time.Time `json:"maxEstimatedDeliveryDate"`
 		ShipToLocationUsedForEstimate struct {
 			Country string `json:"country"`
 		} `json:"shipToLocationUsedForEstimate"`
-		AdditionalShippingCostPerUnit struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"additionalShippingCostPerUnit"`
-		ShippingCostType string `json:"shippingCostType"`
+		AdditionalShippingCostPerUnit Amount `json:"additionalShippingCostPerUnit"`
+		ShippingCostType              string `json:"shippingCostType"`
+		ImportCharges                 Amount `json:"importCharges"`
 	} `json:"shippingOptions"`
 	ShipToLocations struct {
 		RegionIncluded []struct {
 			RegionName string `json:"regionName"`
 			RegionType string `json:"regionType"`
+			RegionID   string `json:"regionId"`
 		} `json:"regionIncluded"`
 		RegionExcluded []struct {
 			RegionName string `json:"regionName"`
 			RegionType string `json:"regionType"`
+			RegionID   string `json:"regionId"`
 		} `json:"regionExcluded"`
 	} `json:"shipToLocations"`
 	ReturnTerms struct {
@@ -305,12 +270,8 @@ type Item struct {
 		ShippingAndHandlingTaxed bool   `json:"shippingAndHandlingTaxed"`
 		IncludedInPrice          bool   `json:"includedInPrice"`
 	} `json:"taxes"`
-	LocalizedAspects []struct {
-		Type  string `json:"type"`
-		Name  string `json:"name"`
-		Value string `json:"value"`
-	} `json:"localizedAspects"`
-	QuantityLimitPerBuyer      int `json:"quantityLimitPerBuyer"`
+	LocalizedAspects           []LocalizedAspect `json:"localizedAspects"`
+	QuantityLimitPerBuyer      int               `json:"quantityLimitPerBuyer"`
 	PrimaryProductReviewRating struct {
 		ReviewCount      int    `json:"reviewCount"`
 		AverageRating    string `json:"averageRating"`
@@ -325,26 +286,15 @@ type Item struct {
 	ItemWebURL               string   `json:"itemWebUrl"`
 	Description              string   `json:"description"`
 	Product                  struct {
-		AspectGroups []struct {
-			LocalizedGroupName string `json:"localizedGroupName"`
-			Aspects            []struct {
-				LocalizedName   string   `json:"localizedName"`
-				LocalizedValues []string `json:"localizedValues"`
-			} `json:"aspects"`
-		} `json:"aspectGroups"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Image       struct {
-			ImageURL string `json:"imageUrl"`
-		} `json:"image"`
-		Gtins                       []string `json:"gtins"`
-		Brand                       string   `json:"brand"`
-		Mpns                        []string `json:"mpns"`
+		AspectGroups                []ProductAspectGroup `json:"aspectGroups"`
+		Title                       string               `json:"title"`
+		Description                 string               `json:"description"`
+		Image                       Image                `json:"image"`
+		Gtins                       []string             `json:"gtins"`
+		Brand                       string               `json:"brand"`
+		Mpns                        []string             `json:"mpns"`
 		AdditionalProductIdentities []struct {
-			ProductIdentity []struct {
-				IdentifierType  string `json:"identifierType"`
-				IdentifierValue string `json:"identifierValue"`
-			} `json:"productIdentity"`
+			ProductIdentity []ProductIdentity `json:"productIdentity"`
 		} `json:"additionalProductIdentities"`
 	} `json:"product"`
 	EnabledForGuestCheckout bool   `json:"enabledForGuestCheckout"`
@@ -353,15 +303,63 @@ type Item struct {
 
 	// Fields not present in the json sample provided by eBay:
 	ItemEndDate       time.Time `json:"itemEndDate"`
-	MinimumPriceToBid struct {
-		Currency string `json:"currency"`
-		Value    string `json:"value"`
-	} `json:"minimumPriceToBid"`
-	CurrentBidPrice struct {
-		Currency string `json:"currency"`
-		Value    string `json:"value"`
-	} `json:"currentBidPrice"`
-	UniqueBidderCount int `json:"uniqueBidderCount"`
+	MinimumPriceToBid Amount    `json:"minimumPriceToBid"`
+	CurrentBidPrice   Amount    `json:"currentBidPrice"`
+	UniqueBidderCount int       `json:"uniqueBidderCount"`
+}
+
+// Image represents an image of an item or a product.
+type Image struct {
+	ImageURL string `json:"imageUrl"`
+}
+
+// Seller represents the seller of an item.
+type Seller struct {
+	Username           string `json:"username"`
+	FeedbackPercentage string `json:"feedbackPercentage"`
+	FeedbackScore      int    `json:"feedbackScore"`
+}
+
+// ItemLocation represents the location of an item.
+type ItemLocation struct {
+	City       string `json:"city"`
+	PostalCode string `json:"postalCode"`
+	Country    string `json:"country"`
+}
+
+// LocalizedAspect represents an aspect of an item, such as its color, in the language of the marketplace.
+type LocalizedAspect struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// PrimaryItemGroup represents the item group an item variation belongs to.
+type PrimaryItemGroup struct {
+	ItemGroupID               string  `json:"itemGroupId"`
+	ItemGroupType             string  `json:"itemGroupType"`
+	ItemGroupHref             string  `json:"itemGroupHref"`
+	ItemGroupTitle            string  `json:"itemGroupTitle"`
+	ItemGroupImage            Image   `json:"itemGroupImage"`
+	ItemGroupAdditionalImages []Image `json:"itemGroupAdditionalImages"`
+}
+
+// ProductAspect represents an aspect of a product and its values.
+type ProductAspect struct {
+	LocalizedName   string   `json:"localizedName"`
+	LocalizedValues []string `json:"localizedValues"`
+}
+
+// ProductAspectGroup represents a group of aspects of a product.
+type ProductAspectGroup struct {
+	LocalizedGroupName string          `json:"localizedGroupName"`
+	Aspects            []ProductAspect `json:"aspects"`
+}
+
+// ProductIdentity represents an identifier of a product, such as an ISBN.
+type ProductIdentity struct {
+	IdentifierType  string `json:"identifierType"`
+	IdentifierValue string `json:"identifierValue"`
 }
 
 // GetItem retrieves the details of a specific item.
@@ -380,35 +378,23 @@ func (s *BrowseService) GetItem(ctx context.Context, itemID string, opts ...Opt)
 // ItemsByGroup represents eBay items by group.
 type ItemsByGroup struct {
 	Items []struct {
-		ItemID             string `json:"itemId"`
-		SellerItemRevision string `json:"sellerItemRevision"`
-		Title              string `json:"title"`
-		ShortDescription   string `json:"shortDescription"`
-		Price              struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"price"`
-		CategoryPath string `json:"categoryPath"`
-		Condition    string `json:"condition"`
-		ConditionID  string `json:"conditionId"`
-		ItemLocation struct {
-			City    string `json:"city"`
-			Country string `json:"country"`
-		} `json:"itemLocation"`
-		Image struct {
-			ImageURL string `json:"imageUrl"`
-		} `json:"image"`
-		Color       string    `json:"color"`
-		Material    string    `json:"material"`
-		Pattern     string    `json:"pattern"`
-		SizeType    string    `json:"sizeType"`
-		Brand       string    `json:"brand"`
-		ItemEndDate time.Time `json:"itemEndDate"`
-		Seller      struct {
-			Username           string `json:"username"`
-			FeedbackPercentage string `json:"feedbackPercentage"`
-			FeedbackScore      int    `json:"feedbackScore"`
-		} `json:"seller"`
+		ItemID                  string       `json:"itemId"`
+		SellerItemRevision      string       `json:"sellerItemRevision"`
+		Title                   string       `json:"title"`
+		ShortDescription        string       `json:"shortDescription"`
+		Price                   Amount       `json:"price"`
+		CategoryPath            string       `json:"categoryPath"`
+		Condition               string       `json:"condition"`
+		ConditionID             string       `json:"conditionId"`
+		ItemLocation            ItemLocation `json:"itemLocation"`
+		Image                   Image        `json:"image"`
+		Color                   string       `json:"color"`
+		Material                string       `json:"material"`
+		Pattern                 string       `json:"pattern"`
+		SizeType                string       `json:"sizeType"`
+		Brand                   string       `json:"brand"`
+		ItemEndDate             time.Time    `json:"itemEndDate"`
+		Seller                  Seller       `json:"seller"`
 		EstimatedAvailabilities []struct {
 			DeliveryOptions             []string `json:"deliveryOptions"`
 			AvailabilityThresholdType   string   `json:"availabilityThresholdType"`
@@ -417,25 +403,19 @@ type ItemsByGroup struct {
 			EstimatedSoldQuantity       int      `json:"estimatedSoldQuantity"`
 		} `json:"estimatedAvailabilities"`
 		ShippingOptions []struct {
-			ShippingServiceCode string `json:"shippingServiceCode"`
-			TrademarkSymbol     string `json:"trademarkSymbol,omitempty"`
-			ShippingCarrierCode string `json:"shippingCarrierCode,omitempty"`
-			Type                string `json:"type"`
-			ShippingCost        struct {
-				Value    string `json:"value"`
-				Currency string `json:"currency"`
-			} `json:"shippingCost"`
+			ShippingServiceCode           string    `json:"shippingServiceCode"`
+			TrademarkSymbol               string    `json:"trademarkSymbol,omitempty"`
+			ShippingCarrierCode           string    `json:"shippingCarrierCode,omitempty"`
+			Type                          string    `json:"type"`
+			ShippingCost                  Amount    `json:"shippingCost"`
 			QuantityUsedForEstimate       int       `json:"quantityUsedForEstimate"`
 			MinEstimatedDeliveryDate      time.Time `json:"minEstimatedDeliveryDate"`
 			MaxEstimatedDeliveryDate      time.Time `json:"maxEstimatedDeliveryDate"`
 			ShipToLocationUsedForEstimate struct {
 				Country string `json:"country"`
 			} `json:"shipToLocationUsedForEstimate"`
-			AdditionalShippingCostPerUnit struct {
-				Value    string `json:"value"`
-				Currency string `json:"currency"`
-			} `json:"additionalShippingCostPerUnit"`
-			ShippingCostType string `json:"shippingCostType"`
+			AdditionalShippingCostPerUnit Amount `json:"additionalShippingCostPerUnit"`
+			ShippingCostType              string `json:"shippingCostType"`
 		} `json:"shippingOptions"`
 		ShipToLocations struct {
 			RegionIncluded []struct {
@@ -457,28 +437,13 @@ type ItemsByGroup struct {
 				Unit  string `json:"unit"`
 			} `json:"returnPeriod"`
 		} `json:"returnTerms"`
-		LocalizedAspects []struct {
-			Type  string `json:"type"`
-			Name  string `json:"name"`
-			Value string `json:"value"`
-		} `json:"localizedAspects"`
-		TopRatedBuyingExperience bool     `json:"topRatedBuyingExperience"`
-		BuyingOptions            []string `json:"buyingOptions"`
-		PrimaryItemGroup         struct {
-			ItemGroupID    string `json:"itemGroupId"`
-			ItemGroupType  string `json:"itemGroupType"`
-			ItemGroupHref  string `json:"itemGroupHref"`
-			ItemGroupTitle string `json:"itemGroupTitle"`
-			ItemGroupImage struct {
-				ImageURL string `json:"imageUrl"`
-			} `json:"itemGroupImage"`
-			ItemGroupAdditionalImages []struct {
-				ImageURL string `json:"imageUrl"`
-			} `json:"itemGroupAdditionalImages"`
-		} `json:"primaryItemGroup"`
-		EnabledForGuestCheckout bool   `json:"enabledForGuestCheckout"`
-		AdultOnly               bool   `json:"adultOnly"`
-		CategoryID              string `json:"categoryId"`
+		LocalizedAspects         []LocalizedAspect `json:"localizedAspects"`
+		TopRatedBuyingExperience bool              `json:"topRatedBuyingExperience"`
+		BuyingOptions            []string          `json:"buyingOptions"`
+		PrimaryItemGroup         PrimaryItemGroup  `json:"primaryItemGroup"`
+		EnabledForGuestCheckout  bool              `json:"enabledForGuestCheckout"`
+		AdultOnly                bool              `json:"adultOnly"`
+		CategoryID               string            `json:"categoryId"`
 	} `json:"items"`
 	CommonDescriptions []struct {
 		Description string   `json:"description"`
@@ -551,69 +516,79 @@ func (s *BrowseService) CheckCompatibility(ctx context.Context, itemID, marketpl
 
 // Search represents the result of an eBay search.
 type Search struct {
-	Href          string `json:"href"`
-	Total         int    `json:"total"`
-	Next          string `json:"next"`
-	Limit         int    `json:"limit"`
-	Offset        int    `json:"offset"`
-	ItemSummaries []struct {
-		ItemID string `json:"itemId"`
-		Title  string `json:"title"`
-		Image  struct {
-			ImageURL string `json:"imageUrl"`
-		} `json:"image"`
-		Price struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"price"`
-		ItemHref string `json:"itemHref"`
-		Seller   struct {
-			Username           string `json:"username"`
-			FeedbackPercentage string `json:"feedbackPercentage"`
-			FeedbackScore      int    `json:"feedbackScore"`
-		} `json:"seller"`
-		MarketingPrice struct {
-			OriginalPrice struct {
-				Value    string `json:"value"`
-				Currency string `json:"currency"`
-			} `json:"originalPrice"`
-			DiscountPercentage string `json:"discountPercentage"`
-			DiscountAmount     struct {
-				Value    string `json:"value"`
-				Currency string `json:"currency"`
-			} `json:"discountAmount"`
-		} `json:"marketingPrice"`
-		Condition       string `json:"condition"`
-		ConditionID     string `json:"conditionId"`
-		ThumbnailImages []struct {
-			ImageURL string `json:"imageUrl"`
-		} `json:"thumbnailImages"`
-		ShippingOptions []struct {
-			ShippingCostType string `json:"shippingCostType"`
-			ShippingCost     struct {
-				Value    string `json:"value"`
-				Currency string `json:"currency"`
-			} `json:"shippingCost"`
-		} `json:"shippingOptions"`
-		BuyingOptions   []string `json:"buyingOptions"`
-		CurrentBidPrice struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"currentBidPrice"`
-		Epid         string `json:"epid"`
-		ItemWebURL   string `json:"itemWebUrl"`
-		ItemLocation struct {
-			PostalCode string `json:"postalCode"`
-			Country    string `json:"country"`
-		} `json:"itemLocation"`
-		Categories []struct {
-			CategoryID string `json:"categoryId"`
-		} `json:"categories"`
-		AdditionalImages []struct {
-			ImageURL string `json:"imageUrl"`
-		} `json:"additionalImages"`
-		AdultOnly bool `json:"adultOnly"`
-	} `json:"itemSummaries"`
+	Href          string        `json:"href"`
+	Total         int           `json:"total"`
+	Next          string        `json:"next"`
+	Prev          string        `json:"prev"`
+	Limit         int           `json:"limit"`
+	Offset        int           `json:"offset"`
+	ItemSummaries []ItemSummary `json:"itemSummaries"`
+	Refinement    Refinement    `json:"refinement"`
+}
+
+// ItemSummary represents an eBay item returned by a search.
+type ItemSummary struct {
+	ItemID         string `json:"itemId"`
+	Title          string `json:"title"`
+	Image          Image  `json:"image"`
+	Price          Amount `json:"price"`
+	ItemHref       string `json:"itemHref"`
+	Seller         Seller `json:"seller"`
+	MarketingPrice struct {
+		OriginalPrice      Amount `json:"originalPrice"`
+		DiscountPercentage string `json:"discountPercentage"`
+		DiscountAmount     Amount `json:"discountAmount"`
+	} `json:"marketingPrice"`
+	Condition       string  `json:"condition"`
+	ConditionID     string  `json:"conditionId"`
+	ThumbnailImages []Image `json:"thumbnailImages"`
+	ShippingOptions []struct {
+		ShippingCostType string `json:"shippingCostType"`
+		ShippingCost     Amount `json:"shippingCost"`
+	} `json:"shippingOptions"`
+	BuyingOptions   []string     `json:"buyingOptions"`
+	CurrentBidPrice Amount       `json:"currentBidPrice"`
+	Epid            string       `json:"epid"`
+	ItemWebURL      string       `json:"itemWebUrl"`
+	ItemLocation    ItemLocation `json:"itemLocation"`
+	Categories      []struct {
+		CategoryID string `json:"categoryId"`
+	} `json:"categories"`
+	AdditionalImages []Image   `json:"additionalImages"`
+	AdultOnly        bool      `json:"adultOnly"`
+	BidCount         int       `json:"bidCount"`
+	ItemEndDate      time.Time `json:"itemEndDate"`
+}
+
+// Refinement represents the distribution of search results returned when
+// a refinement field group is specified.
+type Refinement struct {
+	AspectDistributions []struct {
+		LocalizedAspectName      string `json:"localizedAspectName"`
+		AspectValueDistributions []struct {
+			LocalizedAspectValue string `json:"localizedAspectValue"`
+			MatchCount           int    `json:"matchCount"`
+			RefinementHref       string `json:"refinementHref"`
+		} `json:"aspectValueDistributions"`
+	} `json:"aspectDistributions"`
+	BuyingOptionDistributions []struct {
+		BuyingOption   string `json:"buyingOption"`
+		MatchCount     int    `json:"matchCount"`
+		RefinementHref string `json:"refinementHref"`
+	} `json:"buyingOptionDistributions"`
+	CategoryDistributions []struct {
+		CategoryID     string `json:"categoryId"`
+		CategoryName   string `json:"categoryName"`
+		MatchCount     int    `json:"matchCount"`
+		RefinementHref string `json:"refinementHref"`
+	} `json:"categoryDistributions"`
+	ConditionDistributions []struct {
+		Condition      string `json:"condition"`
+		ConditionID    string `json:"conditionId"`
+		MatchCount     int    `json:"matchCount"`
+		RefinementHref string `json:"refinementHref"`
+	} `json:"conditionDistributions"`
+	DominantCategoryID string `json:"dominantCategoryId"`
 }
 
 func optSearch(param string) func(v string) func(*http.Request) {
@@ -673,7 +648,11 @@ func OptBrowseSearchAspectFilter(v string) func(*http.Request) {
 }
 
 func OptBrowseSearchEPID(epid int) func(*http.Request) {
-	return optSearch("epid")(strconv.Itoa(epid))
+	return OptBrowseSearchEPIDString(strconv.Itoa(epid))
+}
+
+func OptBrowseSearchEPIDString(epid string) func(*http.Request) {
+	return optSearch("epid")(epid)
 }
 
 // Search searches for eBay items.
@@ -688,3 +667,54 @@ func (s *BrowseService) Search(ctx context.Context, opts ...Opt) (Search, error)
 	var search Search
 	return search, s.client.Do(ctx, req, &search)
 }
+
+// BrowseSearchMaxResults is the maximum number of results that can be paginated for a single search.
+const BrowseSearchMaxResults = 10000
+
+func optSearchSet(param, v string) func(*http.Request) {
+	return func(req *http.Request) {
+		query := req.URL.Query()
+		query.Set(param, v)
+		req.URL.RawQuery = query.Encode()
+	}
+}
+
+// SearchPages searches for eBay items and calls fn for each page of results
+// until there are no more results, BrowseSearchMaxResults is reached or fn returns an error.
+// The offset of the first page can be specified with OptBrowseSearchOffset.
+func (s *BrowseService) SearchPages(ctx context.Context, fn func(Search) error, opts ...Opt) error {
+	return searchPages(opts, func(opts []Opt) (searchPage, error) {
+		search, err := s.Search(ctx, opts...)
+		if err != nil {
+			return searchPage{}, err
+		}
+		return searchPage{search.Offset, search.Limit, search.Total, len(search.ItemSummaries), search.Next}, fn(search)
+	})
+}
+
+// searchPage holds the pagination fields of a page of search results.
+type searchPage struct {
+	offset, limit, total, count int
+	next                        string
+}
+
+// searchPages calls fetch with the options of each page until there are no more results,
+// BrowseSearchMaxResults is reached or fetch returns an error.
+func searchPages(opts []Opt, fetch func([]Opt) (searchPage, error)) error {
+	offset := -1
+	for {
+		pageOpts := opts
+		if offset >= 0 {
+			pageOpts = append(opts[:len(opts):len(opts)], optSearchSet("offset", strconv.Itoa(offset)))
+		}
+		page, err := fetch(pageOpts)
+		if err != nil {
+			return err
+		}
+		offset = page.offset + page.limit
+		if page.next == "" || page.count == 0 || page.limit == 0 ||
+			offset >= page.total || offset >= BrowseSearchMaxResults {
+			return nil
+		}
+	}
+}
@@ -139,3 +139,30 @@ func TestSearch(t *testing.T) {
 	assert.Equal(t, 1, search.Total)
 	assert.Equal(t, "itemId", search.ItemSummaries[0].ItemID)
 }
+
+func TestSearchPages(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "search", r.URL.Query().Get("q"))
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"total": 3, "limit": 2, "offset": 0, "next": "next", "itemSummaries": [{"itemId": "1"}, {"itemId": "2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"total": 3, "limit": 2, "offset": 2, "itemSummaries": [{"itemId": "3"}]}`)
+		default:
+			t.Fatalf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var ids []string
+	err := client.Buy.Browse.SearchPages(context.Background(), func(search ebay.Search) error {
+		for _, it := range search.ItemSummaries {
+			ids = append(ids, it.ItemID)
+		}
+		return nil
+	}, ebay.OptBrowseSearch("search"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+}
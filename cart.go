@@ -0,0 +1,89 @@
+package ebay
+
+import (
+	"context"
+	"net/http"
+)
+
+// CartItem represents an item in the shopping cart of a user.
+type CartItem struct {
+	CartItemID       string `json:"cartItemId"`
+	CartItemSubtotal Amount `json:"cartItemSubtotal"`
+	Image            struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"image"`
+	ItemID     string `json:"itemId"`
+	ItemWebURL string `json:"itemWebUrl"`
+	Price      Amount `json:"price"`
+	Quantity   int    `json:"quantity"`
+	Title      string `json:"title"`
+}
+
+// ShoppingCart represents the eBay shopping cart of a user.
+// Items that can no longer be purchased are listed in UnavailableCartItems.
+type ShoppingCart struct {
+	CartItems            []CartItem `json:"cartItems"`
+	CartSubTotal         Amount     `json:"cartSubTotal"`
+	CartWebURL           string     `json:"cartWebUrl"`
+	UnavailableCartItems []CartItem `json:"unavailableCartItems"`
+	Warnings             []Error    `json:"warnings"`
+}
+
+// AddCartItem adds an item to the shopping cart of the user, or increases its quantity if
+// the item is already in the cart. Requires a user access token with ScopeBuyShoppingCart.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/browse/resources/shopping_cart/methods/addItem
+func (s *BrowseService) AddCartItem(ctx context.Context, itemID, marketplaceID string, quantity int, opts ...Opt) (ShoppingCart, error) {
+	type payload struct {
+		ItemID   string `json:"itemId"`
+		Quantity int    `json:"quantity"`
+	}
+	return s.updateShoppingCart(ctx, "add_item", marketplaceID, &payload{itemID, quantity}, opts)
+}
+
+// GetShoppingCart retrieves the shopping cart of the user. An empty cart is returned
+// if the user has no item in their cart. Requires a user access token with ScopeBuyShoppingCart.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/browse/resources/shopping_cart/methods/getShoppingCart
+func (s *BrowseService) GetShoppingCart(ctx context.Context, marketplaceID string, opts ...Opt) (ShoppingCart, error) {
+	opts = append(opts, OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, "buy/browse/v1/shopping_cart/", nil, opts...)
+	if err != nil {
+		return ShoppingCart{}, err
+	}
+	var cart ShoppingCart
+	return cart, s.client.Do(ctx, req, &cart)
+}
+
+// UpdateCartItemQuantity changes the quantity of an item in the shopping cart of the user.
+// Requires a user access token with ScopeBuyShoppingCart.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/browse/resources/shopping_cart/methods/updateQuantity
+func (s *BrowseService) UpdateCartItemQuantity(ctx context.Context, cartItemID, marketplaceID string, quantity int, opts ...Opt) (ShoppingCart, error) {
+	type payload struct {
+		CartItemID string `json:"cartItemId"`
+		Quantity   int    `json:"quantity"`
+	}
+	return s.updateShoppingCart(ctx, "update_quantity", marketplaceID, &payload{cartItemID, quantity}, opts)
+}
+
+// RemoveCartItem removes an item from the shopping cart of the user.
+// Requires a user access token with ScopeBuyShoppingCart.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/browse/resources/shopping_cart/methods/removeItem
+func (s *BrowseService) RemoveCartItem(ctx context.Context, cartItemID, marketplaceID string, opts ...Opt) (ShoppingCart, error) {
+	type payload struct {
+		CartItemID string `json:"cartItemId"`
+	}
+	return s.updateShoppingCart(ctx, "remove_item", marketplaceID, &payload{cartItemID}, opts)
+}
+
+func (s *BrowseService) updateShoppingCart(ctx context.Context, action, marketplaceID string, body interface{}, opts []Opt) (ShoppingCart, error) {
+	opts = append(opts, OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodPost, "buy/browse/v1/shopping_cart/"+action, body, opts...)
+	if err != nil {
+		return ShoppingCart{}, err
+	}
+	var cart ShoppingCart
+	return cart, s.client.Do(ctx, req, &cart)
+}
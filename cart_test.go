@@ -0,0 +1,77 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCartItem(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/shopping_cart/add_item", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		assert.Equal(t, `{"itemId":"v1|202117468662|0","quantity":2}`+"\n", string(body))
+		fmt.Fprint(w, `{"cartItems": [{"cartItemId": "cart", "itemId": "v1|202117468662|0", "quantity": 2,
+			"price": {"value": "5.00", "currency": "USD"}, "cartItemSubtotal": {"value": "10.00", "currency": "USD"}}],
+			"cartSubTotal": {"value": "10.00", "currency": "USD"}}`)
+	})
+
+	cart, err := client.Buy.Browse.AddCartItem(context.Background(), "v1|202117468662|0", ebay.BuyMarketplaceUSA, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "cart", cart.CartItems[0].CartItemID)
+	assert.Equal(t, ebay.Amount{Value: "10.00", Currency: "USD"}, cart.CartItems[0].CartItemSubtotal)
+	assert.Equal(t, ebay.Amount{Value: "10.00", Currency: "USD"}, cart.CartSubTotal)
+}
+
+func TestGetShoppingCartEmpty(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/shopping_cart/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	cart, err := client.Buy.Browse.GetShoppingCart(context.Background(), ebay.BuyMarketplaceUSA)
+	assert.Nil(t, err)
+	assert.Empty(t, cart.CartItems)
+}
+
+func TestUpdateShoppingCart(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	bodies := map[string]string{}
+	mux.HandleFunc("/buy/browse/v1/shopping_cart/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies[r.URL.Path] = string(body)
+		fmt.Fprint(w, `{"cartItems": [{"cartItemId": "cart"}]}`)
+	})
+
+	_, err := client.Buy.Browse.UpdateCartItemQuantity(context.Background(), "cart", ebay.BuyMarketplaceUSA, 3)
+	assert.Nil(t, err)
+	_, err = client.Buy.Browse.RemoveCartItem(context.Background(), "cart", ebay.BuyMarketplaceUSA)
+	assert.Nil(t, err)
+
+	assert.Equal(t, `{"cartItemId":"cart","quantity":3}`+"\n", bodies["/buy/browse/v1/shopping_cart/update_quantity"])
+	assert.Equal(t, `{"cartItemId":"cart"}`+"\n", bodies["/buy/browse/v1/shopping_cart/remove_item"])
+}
@@ -0,0 +1,151 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// CatalogService handles communication with the Catalog API.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/catalog/overview.html
+type CatalogService service
+
+// Valid values for the "fieldgroups" product summary search query parameter.
+const (
+	CatalogFieldgroupMatchingProducts  = "MATCHING_PRODUCTS"
+	CatalogFieldgroupAspectRefinements = "ASPECT_REFINEMENTS"
+	CatalogFieldgroupFull              = "FULL"
+)
+
+// Several query parameters to use with the Search method.
+
+func OptCatalogSearch(v string) func(*http.Request) {
+	return optSearch("q")(v)
+}
+
+func OptCatalogSearchGtin(v string) func(*http.Request) {
+	return optSearch("gtin")(v)
+}
+
+func OptCatalogSearchMpn(v string) func(*http.Request) {
+	return optSearch("mpn")(v)
+}
+
+func OptCatalogSearchCategoryIDs(v string) func(*http.Request) {
+	return optSearch("category_ids")(v)
+}
+
+func OptCatalogSearchAspectFilter(v string) func(*http.Request) {
+	return optSearch("aspect_filter")(v)
+}
+
+func OptCatalogSearchFieldgroups(v string) func(*http.Request) {
+	return optSearch("fieldgroups")(v)
+}
+
+func OptCatalogSearchLimit(limit int) func(*http.Request) {
+	return optSearch("limit")(strconv.Itoa(limit))
+}
+
+func OptCatalogSearchOffset(offset int) func(*http.Request) {
+	return optSearch("offset")(strconv.Itoa(offset))
+}
+
+// ProductSummary represents a product of the eBay catalog matching a search.
+type ProductSummary struct {
+	Epid             string          `json:"epid"`
+	Title            string          `json:"title"`
+	Brand            string          `json:"brand"`
+	Gtin             []string        `json:"gtin"`
+	Mpn              []string        `json:"mpn"`
+	Image            Image           `json:"image"`
+	AdditionalImages []Image         `json:"additionalImages"`
+	Aspects          []ProductAspect `json:"aspects"`
+	ProductHref      string          `json:"productHref"`
+	ProductWebURL    string          `json:"productWebUrl"`
+}
+
+// OptBrowseSearchEPID returns the option to use with BrowseService.Search
+// to search for the items listed for the product found by CatalogService.Search.
+func (p ProductSummary) OptBrowseSearchEPID() Opt {
+	return OptBrowseSearchEPIDString(p.Epid)
+}
+
+// ProductSearch represents a page of product summary search results.
+// Refinement is only set when requested with CatalogFieldgroupAspectRefinements.
+type ProductSearch struct {
+	Href             string           `json:"href"`
+	Total            int              `json:"total"`
+	Next             string           `json:"next"`
+	Prev             string           `json:"prev"`
+	Limit            int              `json:"limit"`
+	Offset           int              `json:"offset"`
+	ProductSummaries []ProductSummary `json:"productSummaries"`
+	Refinement       Refinement       `json:"refinement"`
+}
+
+// Search searches for products of the eBay catalog.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/catalog/resources/product_summary/methods/search
+func (s *CatalogService) Search(ctx context.Context, opts ...Opt) (ProductSearch, error) {
+	u := "commerce/catalog/v1_beta/product_summary/search"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return ProductSearch{}, err
+	}
+	var search ProductSearch
+	return search, s.client.Do(ctx, req, &search)
+}
+
+// SearchPages searches for products of the eBay catalog and calls fn for each page of results.
+// See BrowseService.SearchPages.
+func (s *CatalogService) SearchPages(ctx context.Context, fn func(ProductSearch) error, opts ...Opt) error {
+	return searchPages(opts, func(opts []Opt) (searchPage, error) {
+		search, err := s.Search(ctx, opts...)
+		if err != nil {
+			return searchPage{}, err
+		}
+		return searchPage{search.Offset, search.Limit, search.Total, len(search.ProductSummaries), search.Next}, fn(search)
+	})
+}
+
+// Product represents a product of the eBay catalog.
+type Product struct {
+	Epid                        string          `json:"epid"`
+	Title                       string          `json:"title"`
+	Description                 string          `json:"description"`
+	Brand                       string          `json:"brand"`
+	Gtin                        []string        `json:"gtin"`
+	Mpn                         []string        `json:"mpn"`
+	Image                       Image           `json:"image"`
+	AdditionalImages            []Image         `json:"additionalImages"`
+	Aspects                     []ProductAspect `json:"aspects"`
+	AdditionalProductIdentities []struct {
+		ProductIdentity []ProductIdentity `json:"productIdentity"`
+	} `json:"additionalProductIdentities"`
+	PrimaryCategoryID         string     `json:"primaryCategoryId"`
+	OtherApplicableCategories []Category `json:"otherApplicableCategories"`
+	ProductWebURL             string     `json:"productWebUrl"`
+	Version                   string     `json:"version"`
+}
+
+// OptBrowseSearchEPID returns the option to use with BrowseService.Search
+// to search for the items listed for the catalog product.
+func (p Product) OptBrowseSearchEPID() Opt {
+	return OptBrowseSearchEPIDString(p.Epid)
+}
+
+// GetProduct retrieves a product of the eBay catalog.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/catalog/resources/product/methods/getProduct
+func (s *CatalogService) GetProduct(ctx context.Context, epid string, opts ...Opt) (Product, error) {
+	u := fmt.Sprintf("commerce/catalog/v1_beta/product/%s", epid)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return Product{}, err
+	}
+	var p Product
+	return p, s.client.Do(ctx, req, &p)
+}
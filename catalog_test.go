@@ -0,0 +1,63 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogSearch(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/catalog/v1_beta/product_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, "0190198066473", r.URL.Query().Get("gtin"))
+		assert.Equal(t, "ASPECT_REFINEMENTS", r.URL.Query().Get("fieldgroups"))
+		fmt.Fprint(w, `{"total": 1, "limit": 20, "offset": 0, "productSummaries": [{"epid": "240420050",
+			"image": {"imageUrl": "https://i.ebayimg.com/1.jpg"},
+			"aspects": [{"localizedName": "Color", "localizedValues": ["Space Gray"]}]}],
+			"refinement": {"dominantCategoryId": "9355"}}`)
+	})
+
+	var summaries []ebay.ProductSummary
+	err := client.Commerce.Catalog.SearchPages(context.Background(), func(search ebay.ProductSearch) error {
+		assert.Equal(t, "9355", search.Refinement.DominantCategoryID)
+		summaries = append(summaries, search.ProductSummaries...)
+		return nil
+	}, ebay.OptCatalogSearchGtin("0190198066473"), ebay.OptCatalogSearchFieldgroups(ebay.CatalogFieldgroupAspectRefinements))
+	assert.Nil(t, err)
+	assert.Len(t, summaries, 1)
+	assert.Equal(t, ebay.Image{ImageURL: "https://i.ebayimg.com/1.jpg"}, summaries[0].Image)
+	assert.Equal(t, []ebay.ProductAspect{{LocalizedName: "Color", LocalizedValues: []string{"Space Gray"}}}, summaries[0].Aspects)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.ebay.com/buy/browse/v1/item_summary/search", nil)
+	summaries[0].OptBrowseSearchEPID()(req)
+	assert.Equal(t, "epid=240420050", req.URL.RawQuery)
+}
+
+func TestGetProduct(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/catalog/v1_beta/product/240420050", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"epid": "240420050", "primaryCategoryId": "9355",
+			"additionalProductIdentities": [{"productIdentity": [{"identifierType": "UPC", "identifierValue": "190198066473"}]}],
+			"otherApplicableCategories": [{"categoryId": "1", "categoryName": "Other"}]}`)
+	})
+
+	p, err := client.Commerce.Catalog.GetProduct(context.Background(), "240420050")
+	assert.Nil(t, err)
+	assert.Equal(t, "9355", p.PrimaryCategoryID)
+	assert.Equal(t, ebay.ProductIdentity{IdentifierType: "UPC", IdentifierValue: "190198066473"}, p.AdditionalProductIdentities[0].ProductIdentity[0])
+	assert.Equal(t, []ebay.Category{{CategoryID: "1", CategoryName: "Other"}}, p.OtherApplicableCategories)
+}
@@ -0,0 +1,55 @@
+package ebay
+
+import (
+	"context"
+	"time"
+)
+
+// Clock provides the current time and timers.
+// It allows time dependent code to be tested without waiting.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (systemClock) NewTimer(d time.Duration) *time.Timer   { return time.NewTimer(d) }
+
+// timerClock is implemented by clocks whose timers can be stopped before they fire.
+type timerClock interface {
+	NewTimer(d time.Duration) *time.Timer
+}
+
+// after is like clock.After but also returns a function releasing the timer if the clock supports it.
+func after(clock Clock, d time.Duration) (<-chan time.Time, func()) {
+	if c, ok := clock.(timerClock); ok {
+		t := c.NewTimer(d)
+		return t.C, func() { t.Stop() }
+	}
+	return clock.After(d), func() {}
+}
+
+func clockOrSystem(c Clock) Clock {
+	if c == nil {
+		return systemClock{}
+	}
+	return c
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	c, stop := after(clock, d)
+	defer stop()
+	select {
+	case <-c:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,146 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// PriceComparisonQuery describes a product to compare across marketplaces.
+type PriceComparisonQuery struct {
+	// GTIN or EPID identifies the product.
+	GTIN string
+	EPID int
+
+	// Marketplaces lists the marketplace IDs to search, for example BuyMarketplaceUSA.
+	Marketplaces []string
+
+	// Currency is the currency prices are converted to.
+	// Rates maps a currency to the value of one unit of it expressed in Currency.
+	Currency string
+	Rates    map[string]Decimal
+
+	// Destination, if set, includes the cheapest shipping cost to it in the comparison.
+	Destination *Destination
+
+	// Limit is the maximum number of items retrieved per marketplace.
+	Limit int
+}
+
+// PriceComparison represents an item offering the compared product on a marketplace.
+type PriceComparison struct {
+	MarketplaceID string
+	ItemID        string
+	Title         string
+	ItemWebURL    string
+	Price         Amount
+	// ShippingCost is empty if no destination was specified or eBay did not return a shipping cost.
+	ShippingCost Amount
+	// Total is the price and shipping cost converted to the query currency.
+	Total Decimal
+}
+
+// ComparePrices concurrently searches the product on each marketplace and returns
+// the items found ranked by their total converted price, cheapest first.
+func (s *BrowseService) ComparePrices(ctx context.Context, q PriceComparisonQuery, opts ...Opt) ([]PriceComparison, error) {
+	if (q.GTIN == "") == (q.EPID == 0) {
+		return nil, fmt.Errorf("exactly one of GTIN or EPID must be specified")
+	}
+	opts = opts[:len(opts):len(opts)]
+	if q.GTIN != "" {
+		opts = append(opts, OptBrowseSearchGtin(q.GTIN))
+	} else {
+		opts = append(opts, OptBrowseSearchEPID(q.EPID))
+	}
+	if q.Limit > 0 {
+		opts = append(opts, OptBrowseSearchLimit(q.Limit))
+	}
+	if q.Destination != nil {
+		opts = append(opts, OptBrowseContextualLocation(q.Destination.Country, q.Destination.PostalCode))
+	}
+
+	results := make([][]PriceComparison, len(q.Marketplaces))
+	errs := make([]error, len(q.Marketplaces))
+	var wg sync.WaitGroup
+	for i, marketplaceID := range q.Marketplaces {
+		wg.Add(1)
+		go func(i int, marketplaceID string) {
+			defer wg.Done()
+			search, err := s.Search(ctx, append(opts[:len(opts):len(opts)], OptBuyMarketplace(marketplaceID))...)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			for _, it := range search.ItemSummaries {
+				c, err := q.compare(marketplaceID, it)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				results[i] = append(results[i], c)
+			}
+		}(i, marketplaceID)
+	}
+	wg.Wait()
+
+	var comparisons []PriceComparison
+	for i := range q.Marketplaces {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		comparisons = append(comparisons, results[i]...)
+	}
+	sort.SliceStable(comparisons, func(i, j int) bool {
+		return comparisons[i].Total.Cmp(comparisons[j].Total) < 0
+	})
+	return comparisons, nil
+}
+
+func (q PriceComparisonQuery) compare(marketplaceID string, it ItemSummary) (PriceComparison, error) {
+	c := PriceComparison{
+		MarketplaceID: marketplaceID,
+		ItemID:        it.ItemID,
+		Title:         it.Title,
+		ItemWebURL:    it.ItemWebURL,
+		Price:         it.Price,
+	}
+	total, err := q.convert(it.Price)
+	if err != nil {
+		return c, err
+	}
+	if q.Destination != nil {
+		var cheapest Decimal
+		for _, opt := range it.ShippingOptions {
+			if opt.ShippingCost.Value == "" {
+				continue
+			}
+			cost, err := q.convert(opt.ShippingCost)
+			if err != nil {
+				return c, err
+			}
+			if c.ShippingCost.Value == "" || cost.Cmp(cheapest) < 0 {
+				cheapest = cost
+				c.ShippingCost = opt.ShippingCost
+			}
+		}
+		total = total.Add(cheapest)
+	}
+	c.Total = total
+	return c, nil
+}
+
+func (q PriceComparisonQuery) convert(a Amount) (Decimal, error) {
+	d, err := a.Decimal()
+	if err != nil {
+		return Decimal{}, err
+	}
+	if a.Currency == q.Currency {
+		return d, nil
+	}
+	rate, ok := q.Rates[a.Currency]
+	if !ok {
+		return Decimal{}, fmt.Errorf("no exchange rate from %s to %s", a.Currency, q.Currency)
+	}
+	return d.Mul(rate), nil
+}
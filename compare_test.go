@@ -0,0 +1,60 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComparePrices(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "0190198066473", r.URL.Query().Get("gtin"))
+		assert.Equal(t, "contextualLocation=country%3DUS%2Czip%3D94105", r.Header.Get("X-EBAY-C-ENDUSERCTX"))
+		switch r.Header.Get("X-EBAY-C-MARKETPLACE-ID") {
+		case ebay.BuyMarketplaceUSA:
+			fmt.Fprint(w, `{"itemSummaries": [{"itemId": "us",
+				"price": {"value": "100.00", "currency": "USD"},
+				"shippingOptions": [{"shippingCost": {"value": "10.00", "currency": "USD"}}, {"shippingCost": {"value": "5.00", "currency": "USD"}}]
+			}]}`)
+		case ebay.BuyMarketplaceGreatBritain:
+			fmt.Fprint(w, `{"itemSummaries": [{"itemId": "gb",
+				"price": {"value": "70.00", "currency": "GBP"},
+				"shippingOptions": [{"shippingCost": {"value": "10.00", "currency": "GBP"}}]
+			}]}`)
+		default:
+			t.Fatalf("unexpected marketplace")
+		}
+	})
+
+	rate, _ := ebay.ParseDecimal("1.25")
+	comparisons, err := client.Buy.Browse.ComparePrices(context.Background(), ebay.PriceComparisonQuery{
+		GTIN:         "0190198066473",
+		Marketplaces: []string{ebay.BuyMarketplaceUSA, ebay.BuyMarketplaceGreatBritain},
+		Currency:     "USD",
+		Rates:        map[string]ebay.Decimal{"GBP": rate},
+		Destination:  &ebay.Destination{Country: "US", PostalCode: "94105"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(comparisons))
+	assert.Equal(t, "gb", comparisons[0].ItemID)
+	assert.Equal(t, ebay.BuyMarketplaceGreatBritain, comparisons[0].MarketplaceID)
+	assert.Equal(t, "100", comparisons[0].Total.String())
+	assert.Equal(t, "us", comparisons[1].ItemID)
+	assert.Equal(t, "5.00", comparisons[1].ShippingCost.Value)
+	assert.Equal(t, "105", comparisons[1].Total.String())
+
+	_, err = client.Buy.Browse.ComparePrices(context.Background(), ebay.PriceComparisonQuery{
+		GTIN:         "0190198066473",
+		Marketplaces: []string{ebay.BuyMarketplaceGreatBritain},
+		Currency:     "USD",
+		Destination:  &ebay.Destination{Country: "US", PostalCode: "94105"},
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,161 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CompatibilityPropertyName represents a property used to describe the products
+// compatible with the items of a category, for example "Make" for cars.
+type CompatibilityPropertyName struct {
+	Name          string `json:"name"`
+	LocalizedName string `json:"localizedName"`
+}
+
+// GetCompatibilityProperties retrieves the compatibility properties of a category.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/getCompatibilityProperties
+func (s *TaxonomyService) GetCompatibilityProperties(ctx context.Context, categoryTreeID, categoryID string, opts ...Opt) ([]CompatibilityPropertyName, error) {
+	u := fmt.Sprintf("commerce/taxonomy/v1/category_tree/%s/get_compatibility_properties?category_id=%s", categoryTreeID, url.QueryEscape(categoryID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		CompatibilityProperties []CompatibilityPropertyName `json:"compatibilityProperties"`
+	}
+	return resp.CompatibilityProperties, s.client.Do(ctx, req, &resp)
+}
+
+// GetCompatibilityPropertyValues retrieves the values of a compatibility property of a category,
+// restricted to the values compatible with filter.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/getCompatibilityPropertyValues
+func (s *TaxonomyService) GetCompatibilityPropertyValues(ctx context.Context, categoryTreeID, categoryID, property string, filter []CompatibilityProperty, opts ...Opt) ([]string, error) {
+	params := url.Values{}
+	params.Set("category_id", categoryID)
+	params.Set("compatibility_property", property)
+	if len(filter) > 0 {
+		for _, p := range filter {
+			if err := checkCompatibilityValue(p.Value); err != nil {
+				return nil, err
+			}
+		}
+		params.Set("filter", compatibilityFilter(filter, ","))
+	}
+	u := fmt.Sprintf("commerce/taxonomy/v1/category_tree/%s/get_compatibility_property_values?%s", categoryTreeID, params.Encode())
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		CompatibilityPropertyValues []struct {
+			Value string `json:"value"`
+		} `json:"compatibilityPropertyValues"`
+	}
+	if err := s.client.Do(ctx, req, &resp); err != nil {
+		return nil, err
+	}
+	values := make([]string, len(resp.CompatibilityPropertyValues))
+	for i, v := range resp.CompatibilityPropertyValues {
+		values[i] = v.Value
+	}
+	return values, nil
+}
+
+// checkCompatibilityValue rejects the values that cannot be used in a filter
+// since eBay provides no way to escape the separators of its properties.
+func checkCompatibilityValue(value string) error {
+	if strings.ContainsAny(value, ",;") {
+		return errors.Errorf("compatibility property value %q cannot contain ',' or ';'", value)
+	}
+	return nil
+}
+
+func compatibilityFilter(properties []CompatibilityProperty, sep string) string {
+	pairs := make([]string, len(properties))
+	for i, p := range properties {
+		pairs[i] = p.Name + ":" + p.Value
+	}
+	return strings.Join(pairs, sep)
+}
+
+// ErrCompatibilityNextFirst is returned by CompatibilityBuilder.Select when the property names
+// are not known yet.
+var ErrCompatibilityNextFirst = errors.New("compatibility: call Next before Select")
+
+// CompatibilityBuilder guides the selection of the compatibility properties of a product,
+// one property at a time, each selection narrowing the values of the next properties.
+type CompatibilityBuilder struct {
+	Taxonomy       *TaxonomyService
+	CategoryTreeID string
+	CategoryID     string
+
+	// PropertyNames is the order in which properties are selected, for example
+	// Year, Make, Model, Trim then Engine. Defaults to the order of GetCompatibilityProperties.
+	PropertyNames []string
+
+	selected []CompatibilityProperty
+}
+
+// Next returns the next property to select and its values compatible with the
+// properties already selected. property is empty once every property is selected.
+func (b *CompatibilityBuilder) Next(ctx context.Context, opts ...Opt) (property string, values []string, err error) {
+	if b.PropertyNames == nil {
+		names, err := b.Taxonomy.GetCompatibilityProperties(ctx, b.CategoryTreeID, b.CategoryID, opts...)
+		if err != nil {
+			return "", nil, err
+		}
+		b.PropertyNames = []string{}
+		for _, n := range names {
+			b.PropertyNames = append(b.PropertyNames, n.Name)
+		}
+	}
+	if len(b.selected) >= len(b.PropertyNames) {
+		return "", nil, nil
+	}
+	property = b.PropertyNames[len(b.selected)]
+	values, err = b.Taxonomy.GetCompatibilityPropertyValues(ctx, b.CategoryTreeID, b.CategoryID, property, b.selected, opts...)
+	return property, values, err
+}
+
+// Select sets the value of a property, typically one of the values returned by Next.
+// The properties selected after it are cleared since their values may no longer be compatible.
+// Values containing ',' or ';' are refused as they cannot be used in a filter.
+//
+// Unless PropertyNames is set, Next must be called first to retrieve the property names,
+// otherwise ErrCompatibilityNextFirst is returned.
+func (b *CompatibilityBuilder) Select(property, value string) error {
+	if b.PropertyNames == nil {
+		return ErrCompatibilityNextFirst
+	}
+	if err := checkCompatibilityValue(value); err != nil {
+		return err
+	}
+	for i, name := range b.PropertyNames {
+		if name != property {
+			continue
+		}
+		if i > len(b.selected) {
+			return errors.Errorf("property %s selected before %s", property, b.PropertyNames[len(b.selected)])
+		}
+		b.selected = append(b.selected[:i], CompatibilityProperty{Name: property, Value: value})
+		return nil
+	}
+	return errors.Errorf("unknown compatibility property %s", property)
+}
+
+// Properties returns the properties selected, to use with BrowseService.CheckCompatibility.
+func (b *CompatibilityBuilder) Properties() []CompatibilityProperty {
+	return append([]CompatibilityProperty(nil), b.selected...)
+}
+
+// Filter returns the properties selected as a filter to use with OptBrowseSearchCompatibilityFilter.
+func (b *CompatibilityBuilder) Filter() string {
+	return compatibilityFilter(b.selected, ";")
+}
@@ -0,0 +1,91 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompatibilityBuilder(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/taxonomy/v1/category_tree/100/get_compatibility_properties", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, "33559", r.URL.Query().Get("category_id"))
+		fmt.Fprint(w, `{"compatibilityProperties": [{"name": "Year"}, {"name": "Make"}, {"name": "Model"}]}`)
+	})
+	mux.HandleFunc("/commerce/taxonomy/v1/category_tree/100/get_compatibility_property_values", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, "33559", r.URL.Query().Get("category_id"))
+		switch p, filter := r.URL.Query().Get("compatibility_property"), r.URL.Query().Get("filter"); {
+		case p == "Year" && filter == "":
+			fmt.Fprint(w, `{"compatibilityPropertyValues": [{"value": "2018"}, {"value": "2019"}]}`)
+		case p == "Make" && filter == "Year:2018":
+			fmt.Fprint(w, `{"compatibilityPropertyValues": [{"value": "Honda"}, {"value": "Toyota"}]}`)
+		case p == "Make" && filter == "Year:2019":
+			fmt.Fprint(w, `{"compatibilityPropertyValues": [{"value": "Toyota"}]}`)
+		case p == "Model" && filter == "Year:2019,Make:Toyota":
+			fmt.Fprint(w, `{"compatibilityPropertyValues": [{"value": "Camry"}]}`)
+		default:
+			t.Fatalf("unexpected property %s with filter %s", p, filter)
+		}
+	})
+
+	ctx := context.Background()
+	b := ebay.CompatibilityBuilder{Taxonomy: client.Commerce.Taxonomy, CategoryTreeID: "100", CategoryID: "33559"}
+	assert.Equal(t, ebay.ErrCompatibilityNextFirst, b.Select("Year", "2018"))
+	property, values, err := b.Next(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "Year", property)
+	assert.Equal(t, []string{"2018", "2019"}, values)
+
+	assert.Nil(t, b.Select("Year", "2018"))
+	assert.NotNil(t, b.Select("Model", "Camry"))
+	assert.NotNil(t, b.Select("Trim", "LE"))
+	assert.NotNil(t, b.Select("Year", "2018,Make:Honda"))
+	assert.NotNil(t, b.Select("Year", "2018;Make:Honda"))
+	property, values, err = b.Next(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "Make", property)
+	assert.Equal(t, []string{"Honda", "Toyota"}, values)
+	assert.Nil(t, b.Select("Make", "Honda"))
+
+	// Changing the year clears the make.
+	assert.Nil(t, b.Select("Year", "2019"))
+	property, values, err = b.Next(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "Make", property)
+	assert.Equal(t, []string{"Toyota"}, values)
+	assert.Nil(t, b.Select("Make", "Toyota"))
+
+	property, values, err = b.Next(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "Model", property)
+	assert.Nil(t, b.Select("Model", values[0]))
+
+	property, _, err = b.Next(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "", property)
+	assert.Equal(t, []ebay.CompatibilityProperty{
+		{Name: "Year", Value: "2019"}, {Name: "Make", Value: "Toyota"}, {Name: "Model", Value: "Camry"},
+	}, b.Properties())
+	assert.Equal(t, "Year:2019;Make:Toyota;Model:Camry", b.Filter())
+}
+
+func TestGetCompatibilityPropertyValuesSeparator(t *testing.T) {
+	client, _, teardown := setup(t)
+	defer teardown()
+
+	_, err := client.Commerce.Taxonomy.GetCompatibilityPropertyValues(context.Background(), "100", "33559", "Make",
+		[]ebay.CompatibilityProperty{{Name: "Year", Value: "2018,2019"}})
+	assert.NotNil(t, err)
+}
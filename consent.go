@@ -0,0 +1,156 @@
+package ebay
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AdultOnlyTermsURL is the URL of the eBay "Terms of use for Adult Only category".
+const AdultOnlyTermsURL = "https://signin.ebay.com/ws/eBayISAPI.dll?AdultSignIn2"
+
+// ErrAdultOnlyConsentRequired is returned when bidding on an adult-only item
+// on behalf of a user with no valid consent record.
+var ErrAdultOnlyConsentRequired = errors.New("adult-only item requires the user consent")
+
+// AdultOnlyConsent records a user agreeing to the terms of use for adult-only items.
+type AdultOnlyConsent struct {
+	ID       string    `json:"id"`
+	UserID   string    `json:"userId"`
+	Time     time.Time `json:"time"`
+	TermsURL string    `json:"termsUrl"`
+}
+
+func (c *AdultOnlyConsent) valid(userID string) bool {
+	return c != nil && c.UserID == userID && !c.Time.IsZero() && c.TermsURL != ""
+}
+
+// ConsentStore persists the consent records of users.
+type ConsentStore interface {
+	// AdultOnlyConsent returns the latest consent of a user, or nil if the user never consented.
+	AdultOnlyConsent(ctx context.Context, userID string) (*AdultOnlyConsent, error)
+	// RecordAdultOnlyConsent adds a consent record.
+	RecordAdultOnlyConsent(ctx context.Context, consent AdultOnlyConsent) error
+}
+
+// FileConsentStore is a ConsentStore saving every consent record as a JSON file at Path.
+// Previous records are kept so that the consent a bid relied on can be demonstrated later.
+type FileConsentStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+func (s *FileConsentStore) load() (map[string][]AdultOnlyConsent, error) {
+	records := map[string][]AdultOnlyConsent{}
+	b, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return records, errors.WithStack(json.Unmarshal(b, &records))
+}
+
+// AdultOnlyConsent allows FileConsentStore to implement ConsentStore.
+func (s *FileConsentStore) AdultOnlyConsent(ctx context.Context, userID string) (*AdultOnlyConsent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	consents := records[userID]
+	if len(consents) == 0 {
+		return nil, nil
+	}
+	return &consents[len(consents)-1], nil
+}
+
+// RecordAdultOnlyConsent allows FileConsentStore to implement ConsentStore.
+// The file is replaced atomically.
+func (s *FileConsentStore) RecordAdultOnlyConsent(ctx context.Context, consent AdultOnlyConsent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.load()
+	if err != nil {
+		return err
+	}
+	records[consent.UserID] = append(records[consent.UserID], consent)
+	b, err := json.Marshal(records)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeFileAtomic(s.Path, b)
+}
+
+// ConsentingBidder places proxy bids on behalf of users and refuses to bid on adult-only items
+// unless the user consent is recorded in Store.
+type ConsentingBidder struct {
+	Browse *BrowseService
+	Bidder ProxyBidder
+	Store  ConsentStore
+
+	// Clock defaults to the system clock.
+	Clock Clock
+}
+
+// ConsentedProxyBid represents a proxy bid placed on behalf of a user.
+// Consent is the consent the bid relied on, nil if the item is not adult-only.
+type ConsentedProxyBid struct {
+	ProxyBid
+	Consent *AdultOnlyConsent
+}
+
+// RecordAdultOnlyConsent records that userID agreed to the terms at AdultOnlyTermsURL now.
+func (b *ConsentingBidder) RecordAdultOnlyConsent(ctx context.Context, userID string) (AdultOnlyConsent, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return AdultOnlyConsent{}, errors.WithStack(err)
+	}
+	consent := AdultOnlyConsent{
+		ID:       hex.EncodeToString(id),
+		UserID:   userID,
+		Time:     clockOrSystem(b.Clock).Now(),
+		TermsURL: AdultOnlyTermsURL,
+	}
+	return consent, b.Store.RecordAdultOnlyConsent(ctx, consent)
+}
+
+// PlaceProxyBid retrieves the item to check whether it is adult-only then places
+// the proxy bid on behalf of userID. See PlaceProxyBidOnItem.
+func (b *ConsentingBidder) PlaceProxyBid(ctx context.Context, userID, itemID, marketplaceID, maxAmount, currency string, opts ...Opt) (ConsentedProxyBid, error) {
+	it, err := b.Browse.GetItem(ctx, itemID, OptBuyMarketplace(marketplaceID))
+	if err != nil {
+		return ConsentedProxyBid{}, err
+	}
+	return b.PlaceProxyBidOnItem(ctx, userID, it, marketplaceID, maxAmount, currency, opts...)
+}
+
+// PlaceProxyBidOnItem places a proxy bid on behalf of userID on an item returned by the Browse API.
+// ErrAdultOnlyConsentRequired is returned if the item is adult-only and Store holds no consent
+// of the user with a time and a terms URL.
+func (b *ConsentingBidder) PlaceProxyBidOnItem(ctx context.Context, userID string, it Item, marketplaceID, maxAmount, currency string, opts ...Opt) (ConsentedProxyBid, error) {
+	var result ConsentedProxyBid
+	if it.AdultOnly {
+		consent, err := b.Store.AdultOnlyConsent(ctx, userID)
+		if err != nil {
+			return result, err
+		}
+		if !consent.valid(userID) {
+			return result, ErrAdultOnlyConsentRequired
+		}
+		result.Consent = consent
+	}
+	bid, err := b.Bidder.PlaceProxyBid(ctx, it.ItemID, marketplaceID, maxAmount, currency, it.AdultOnly, opts...)
+	result.ProxyBid = bid
+	return result, err
+}
@@ -0,0 +1,60 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsentingBidder(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"itemId": "v1|202117468662|0", "adultOnly": true}`)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"maxAmount":{"currency":"USD","value":"1.23"},"userConsent":{"adultOnlyItem":true}}
+`, string(body))
+		fmt.Fprint(w, `{"proxyBidId": "123"}`)
+	})
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	store := &ebay.FileConsentStore{Path: filepath.Join(dir, "consent.json")}
+	bidder := ebay.ConsentingBidder{
+		Browse: client.Buy.Browse,
+		Bidder: client.Buy.Offer,
+		Store:  store,
+		Clock:  &fakeClock{now: now},
+	}
+	ctx := context.Background()
+	consent, err := bidder.RecordAdultOnlyConsent(ctx, "alice")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, consent.ID)
+	assert.Nil(t, store.RecordAdultOnlyConsent(ctx, ebay.AdultOnlyConsent{UserID: "bob"}))
+
+	bid, err := bidder.PlaceProxyBid(ctx, "alice", "v1|202117468662|0", ebay.BuyMarketplaceUSA, "1.23", "USD")
+	assert.Nil(t, err)
+	assert.Equal(t, "123", bid.ProxyBidID)
+	// The bid references the consent it relied on.
+	assert.Equal(t, consent.ID, bid.Consent.ID)
+	assert.True(t, now.Equal(bid.Consent.Time))
+
+	for _, user := range []string{"bob", "carol"} {
+		_, err = bidder.PlaceProxyBid(ctx, user, "v1|202117468662|0", ebay.BuyMarketplaceUSA, "1.23", "USD")
+		assert.Equal(t, ebay.ErrAdultOnlyConsentRequired, err)
+	}
+}
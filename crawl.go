@@ -0,0 +1,359 @@
+package ebay
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CrawlPartition represents a subset of the results of a search.
+// Empty fields are not constrained. Prices are inclusive.
+type CrawlPartition struct {
+	PriceMin    string `json:"priceMin,omitempty"`
+	PriceMax    string `json:"priceMax,omitempty"`
+	ConditionID string `json:"conditionId,omitempty"`
+	CategoryID  string `json:"categoryId,omitempty"`
+	// Remainder is set on a partition crawled as is, up to BrowseSearchMaxResults, to reach
+	// the items not covered by the refinements used to split it.
+	Remainder bool `json:"remainder,omitempty"`
+}
+
+// CrawlState represents the progress of a crawl and can be used to resume it.
+// It can be serialized to JSON.
+type CrawlState struct {
+	// Pending lists the partitions left to crawl. The first one is being crawled.
+	Pending []CrawlPartition `json:"pending"`
+	// Offset is the offset reached in the first pending partition.
+	Offset int `json:"offset"`
+}
+
+// CrawlSeen records the IDs of the items emitted by a crawl, so that items returned
+// by several partitions are emitted once. It is kept apart from CrawlState since
+// it grows with every item while the state is saved at every page.
+type CrawlSeen interface {
+	Has(itemID string) (bool, error)
+	// Add is called with the IDs of the items emitted from a page, before the checkpoint.
+	Add(itemIDs []string) error
+}
+
+// CrawlSeenMap is an in-memory CrawlSeen.
+type CrawlSeenMap map[string]bool
+
+// Has allows CrawlSeenMap to implement CrawlSeen.
+func (m CrawlSeenMap) Has(itemID string) (bool, error) {
+	return m[itemID], nil
+}
+
+// Add allows CrawlSeenMap to implement CrawlSeen.
+func (m CrawlSeenMap) Add(itemIDs []string) error {
+	for _, id := range itemIDs {
+		m[id] = true
+	}
+	return nil
+}
+
+// FileCrawlSeen is a CrawlSeen appending the item IDs to the file at Path, one per line.
+// The file is read on first use, so a resumed crawl does not emit the items seen before.
+type FileCrawlSeen struct {
+	Path string
+
+	ids map[string]bool
+}
+
+func (s *FileCrawlSeen) load() error {
+	if s.ids != nil {
+		return nil
+	}
+	ids := map[string]bool{}
+	f, err := os.Open(s.Path)
+	if os.IsNotExist(err) {
+		s.ids = ids
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if id := scanner.Text(); id != "" {
+			ids[id] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.WithStack(err)
+	}
+	s.ids = ids
+	return nil
+}
+
+// Has allows FileCrawlSeen to implement CrawlSeen.
+func (s *FileCrawlSeen) Has(itemID string) (bool, error) {
+	if err := s.load(); err != nil {
+		return false, err
+	}
+	return s.ids[itemID], nil
+}
+
+// Add allows FileCrawlSeen to implement CrawlSeen.
+func (s *FileCrawlSeen) Add(itemIDs []string) error {
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	if err := s.load(); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := f.WriteString(strings.Join(itemIDs, "\n") + "\n"); err != nil {
+		f.Close()
+		return errors.WithStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, id := range itemIDs {
+		s.ids[id] = true
+	}
+	return nil
+}
+
+// Crawler retrieves every result of a search by partitioning it into
+// searches returning less than BrowseSearchMaxResults results each.
+//
+// Searches are first partitioned by price ranges. Partitions that can't be split
+// by price any further are split by condition then by category using the search refinements.
+// When the refinements don't cover every result, a remainder partition is crawled as is.
+// Partitions that still exceed BrowseSearchMaxResults are crawled up to that limit.
+type Crawler struct {
+	Browse *BrowseService
+
+	// Currency is the currency used by the price filters, for example "USD".
+	Currency string
+
+	// PageSize is the number of items requested per page. Defaults to 200.
+	PageSize int
+
+	// Checkpoint, if set, is called every time the state of the crawl changes.
+	// Persisting the state allows to resume an interrupted crawl.
+	Checkpoint func(*CrawlState) error
+
+	// Seen records the items emitted. Defaults to a new CrawlSeenMap for each call to Crawl,
+	// a persistent CrawlSeen such as FileCrawlSeen is needed for resumed crawls not to emit items twice.
+	Seen CrawlSeen
+}
+
+// Crawl calls fn once for each item matching the search described by opts.
+// Crawl starts a new crawl if state is nil and resumes the crawl described by state otherwise.
+// Items emitted by an interrupted crawl are emitted again when it is resumed, unless Seen persists them.
+//
+// Options that set a price or condition filter or a category must not be used.
+func (c *Crawler) Crawl(ctx context.Context, state *CrawlState, fn func(ItemSummary) error, opts ...Opt) error {
+	if c.Currency == "" {
+		return fmt.Errorf("crawler currency is required")
+	}
+	if state == nil {
+		state = &CrawlState{Pending: []CrawlPartition{{}}}
+	}
+	seen := c.Seen
+	if seen == nil {
+		seen = CrawlSeenMap{}
+	}
+	for len(state.Pending) > 0 {
+		p := state.Pending[0]
+		if state.Offset == 0 {
+			children, err := c.split(ctx, p, opts)
+			if err != nil {
+				return err
+			}
+			if len(children) > 0 {
+				state.Pending = append(children, state.Pending[1:]...)
+				if err := c.checkpoint(state); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		pageOpts := append(c.partitionOpts(p, opts), optSearchSet("limit", strconv.Itoa(c.pageSize())))
+		if state.Offset > 0 {
+			pageOpts = append(pageOpts, OptBrowseSearchOffset(state.Offset))
+		}
+		err := c.Browse.SearchPages(ctx, func(search Search) error {
+			var emitted []string
+			for _, it := range search.ItemSummaries {
+				ok, err := seen.Has(it.ItemID)
+				if err != nil {
+					return err
+				}
+				if ok || contains(emitted, it.ItemID) {
+					continue
+				}
+				if err := fn(it); err != nil {
+					if addErr := seen.Add(emitted); addErr != nil {
+						return addErr
+					}
+					return err
+				}
+				emitted = append(emitted, it.ItemID)
+			}
+			if err := seen.Add(emitted); err != nil {
+				return err
+			}
+			state.Offset = search.Offset + search.Limit
+			return c.checkpoint(state)
+		}, pageOpts...)
+		if err != nil {
+			return err
+		}
+		state.Pending = state.Pending[1:]
+		state.Offset = 0
+		if err := c.checkpoint(state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Crawler) pageSize() int {
+	if c.PageSize <= 0 {
+		return 200
+	}
+	return c.PageSize
+}
+
+func (c *Crawler) checkpoint(state *CrawlState) error {
+	if c.Checkpoint == nil {
+		return nil
+	}
+	return c.Checkpoint(state)
+}
+
+// split returns the sub-partitions of p, or nil if p does not need to be split.
+func (c *Crawler) split(ctx context.Context, p CrawlPartition, opts []Opt) ([]CrawlPartition, error) {
+	if p.CategoryID != "" || p.Remainder {
+		return nil, nil
+	}
+	fieldgroups := "MATCHING_ITEMS,CATEGORY_REFINEMENTS"
+	if p.ConditionID == "" {
+		fieldgroups += ",CONDITION_REFINEMENTS"
+	}
+	countOpts := append(c.partitionOpts(p, opts), optSearchSet("limit", "1"), optSearchSet("fieldgroups", fieldgroups))
+	search, err := c.Browse.Search(ctx, countOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if search.Total <= BrowseSearchMaxResults {
+		return nil, nil
+	}
+	if p.ConditionID == "" {
+		children, err := splitPrice(p)
+		if err != errCrawlCannotSplit {
+			return children, err
+		}
+		var conditions []CrawlPartition
+		count := 0
+		for _, d := range search.Refinement.ConditionDistributions {
+			child := p
+			child.ConditionID = d.ConditionID
+			conditions = append(conditions, child)
+			count += d.MatchCount
+		}
+		if count >= search.Total {
+			return conditions, nil
+		}
+	}
+	var children []CrawlPartition
+	count := 0
+	for _, d := range search.Refinement.CategoryDistributions {
+		child := p
+		child.CategoryID = d.CategoryID
+		children = append(children, child)
+		count += d.MatchCount
+	}
+	if count < search.Total {
+		// Items outside of the returned categories are only reachable through p itself.
+		remainder := p
+		remainder.Remainder = true
+		children = append(children, remainder)
+	}
+	return children, nil
+}
+
+// errCrawlCannotSplit is returned by splitPrice for a partition limited to a single price.
+var errCrawlCannotSplit = errors.New("price range cannot be split further")
+
+// splitPrice splits the price range of p in two, or returns errCrawlCannotSplit.
+func splitPrice(p CrawlPartition) ([]CrawlPartition, error) {
+	cent, _ := ParseDecimal("0.01")
+	var min Decimal
+	if p.PriceMin != "" {
+		var err error
+		if min, err = ParseDecimal(p.PriceMin); err != nil {
+			return nil, err
+		}
+	}
+	var mid Decimal
+	if p.PriceMax == "" {
+		// Unbounded ranges grow geometrically.
+		mid = min.Mul(NewDecimal(2)).Add(NewDecimal(100))
+	} else {
+		max, err := ParseDecimal(p.PriceMax)
+		if err != nil {
+			return nil, err
+		}
+		if min.Cmp(max) >= 0 {
+			return nil, errCrawlCannotSplit
+		}
+		half, _ := ParseDecimal("0.5")
+		mid = min.Add(max).Mul(half).Round(2)
+		if mid.Cmp(max) >= 0 {
+			// The midpoint was rounded up to max, as for 1.00..1.01.
+			mid = max.Sub(cent)
+		}
+	}
+	low, high := p, p
+	low.PriceMax = mid.StringFixed(2)
+	high.PriceMin = mid.Add(cent).StringFixed(2)
+	return []CrawlPartition{low, high}, nil
+}
+
+func (c *Crawler) partitionOpts(p CrawlPartition, opts []Opt) []Opt {
+	opts = opts[:len(opts):len(opts)]
+	if p.PriceMin != "" || p.PriceMax != "" {
+		r := p.PriceMin
+		if p.PriceMax != "" {
+			r += ".." + p.PriceMax
+		}
+		opts = append(opts, optSearchAppendFilter(fmt.Sprintf("price:[%s],priceCurrency:%s", r, c.Currency)))
+	}
+	if p.ConditionID != "" {
+		opts = append(opts, optSearchAppendFilter(fmt.Sprintf("conditionIds:{%s}", p.ConditionID)))
+	}
+	if p.CategoryID != "" {
+		opts = append(opts, optSearchSet("category_ids", p.CategoryID))
+	}
+	return opts
+}
+
+// optSearchAppendFilter merges the existing "filter" query parameters and adds filters to them.
+func optSearchAppendFilter(v string) func(*http.Request) {
+	return func(req *http.Request) {
+		query := req.URL.Query()
+		var filters []string
+		for _, f := range query["filter"] {
+			if f != "" {
+				filters = append(filters, f)
+			}
+		}
+		query.Set("filter", strings.Join(append(filters, v), ","))
+		req.URL.RawQuery = query.Encode()
+	}
+}
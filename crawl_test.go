@@ -0,0 +1,233 @@
+package ebay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+// crawlPartitions maps the filters of a search to the IDs of the items it returns.
+// A nil value stands for too many results.
+var crawlPartitions = map[string][]string{
+	"":                                   nil,
+	"price:[..100.00],priceCurrency:USD": {"1", "2", "3", "4", "5"},
+	"price:[100.01],priceCurrency:USD":   {"5", "6", "7"},
+}
+
+func serveCrawl(t *testing.T, partitions map[string][]string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "drone", q.Get("q"))
+		ids, ok := partitions[q.Get("filter")]
+		if !ok {
+			t.Errorf("unexpected filter %s", q.Get("filter"))
+			return
+		}
+		total := len(ids)
+		if ids == nil {
+			total = ebay.BrowseSearchMaxResults + 1
+		}
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		search := ebay.Search{Total: total, Limit: limit, Offset: offset}
+		for i := offset; i < offset+limit && i < len(ids); i++ {
+			search.ItemSummaries = append(search.ItemSummaries, ebay.ItemSummary{ItemID: ids[i]})
+		}
+		if offset+limit < total {
+			search.Next = "next"
+		}
+		json.NewEncoder(w).Encode(search)
+	}
+}
+
+func TestCrawl(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", serveCrawl(t, crawlPartitions))
+
+	var states []ebay.CrawlState
+	crawler := ebay.Crawler{
+		Browse:   client.Buy.Browse,
+		Currency: "USD",
+		PageSize: 2,
+		Seen:     ebay.CrawlSeenMap{},
+		Checkpoint: func(state *ebay.CrawlState) error {
+			b, _ := json.Marshal(state)
+			var s ebay.CrawlState
+			json.Unmarshal(b, &s)
+			states = append(states, s)
+			return nil
+		},
+	}
+
+	// Interrupt the crawl after the third item.
+	var ids []string
+	errStop := errors.New("stop")
+	err := crawler.Crawl(context.Background(), nil, func(it ebay.ItemSummary) error {
+		if len(ids) == 3 {
+			return errStop
+		}
+		ids = append(ids, it.ItemID)
+		return nil
+	}, ebay.OptBrowseSearch("drone"))
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+
+	state := states[len(states)-1]
+	assert.Equal(t, 2, state.Offset)
+	err = crawler.Crawl(context.Background(), &state, func(it ebay.ItemSummary) error {
+		ids = append(ids, it.ItemID)
+		return nil
+	}, ebay.OptBrowseSearch("drone"))
+	assert.Nil(t, err)
+	// Item 3 was emitted after the last checkpoint but is recorded as seen.
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7"}, ids)
+	assert.Equal(t, 0, len(states[len(states)-1].Pending))
+}
+
+func TestCrawlMergesFilters(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	partitions := map[string][]string{
+		"buyingOptions:{AUCTION},itemLocationCountry:US":                                    nil,
+		"buyingOptions:{AUCTION},itemLocationCountry:US,price:[..100.00],priceCurrency:USD": {"1"},
+		"buyingOptions:{AUCTION},itemLocationCountry:US,price:[100.01],priceCurrency:USD":   {"2"},
+	}
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		// The unpartitioned search keeps the filters of the caller as is.
+		filter := strings.Join(r.URL.Query()["filter"], ",")
+		ids, ok := partitions[filter]
+		if !ok {
+			t.Errorf("unexpected filter %s", filter)
+			return
+		}
+		search := ebay.Search{Total: len(ids), Limit: 1}
+		if ids == nil {
+			search.Total = ebay.BrowseSearchMaxResults + 1
+		}
+		for _, id := range ids {
+			search.ItemSummaries = append(search.ItemSummaries, ebay.ItemSummary{ItemID: id})
+		}
+		json.NewEncoder(w).Encode(search)
+	})
+
+	var ids []string
+	crawler := ebay.Crawler{Browse: client.Buy.Browse, Currency: "USD"}
+	err := crawler.Crawl(context.Background(), nil, func(it ebay.ItemSummary) error {
+		ids = append(ids, it.ItemID)
+		return nil
+	}, ebay.OptBrowseSearchFilter("buyingOptions:{AUCTION}"), ebay.OptBrowseSearchFilter("itemLocationCountry:US"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2"}, ids)
+}
+
+func TestCrawlResumeFileSeen(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", serveCrawl(t, crawlPartitions))
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var state ebay.CrawlState
+	newCrawler := func() ebay.Crawler {
+		return ebay.Crawler{
+			Browse:   client.Buy.Browse,
+			Currency: "USD",
+			PageSize: 2,
+			Seen:     &ebay.FileCrawlSeen{Path: filepath.Join(dir, "seen")},
+			Checkpoint: func(s *ebay.CrawlState) error {
+				b, _ := json.Marshal(s)
+				state = ebay.CrawlState{}
+				return json.Unmarshal(b, &state)
+			},
+		}
+	}
+
+	// Interrupt the crawl in the second partition, which also returns item 5.
+	var ids []string
+	errStop := errors.New("stop")
+	crawler := newCrawler()
+	err = crawler.Crawl(context.Background(), nil, func(it ebay.ItemSummary) error {
+		if it.ItemID == "7" {
+			return errStop
+		}
+		ids = append(ids, it.ItemID)
+		return nil
+	}, ebay.OptBrowseSearch("drone"))
+	assert.Equal(t, errStop, err)
+
+	// A new process resumes the crawl.
+	crawler = newCrawler()
+	err = crawler.Crawl(context.Background(), &state, func(it ebay.ItemSummary) error {
+		ids = append(ids, it.ItemID)
+		return nil
+	}, ebay.OptBrowseSearch("drone"))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7"}, ids)
+}
+
+func TestCrawlSinglePrices(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	// 11000 items are listed at 1.00 and 11000 at 1.01. The condition refinement only covers 10 of them.
+	prices := regexp.MustCompile(`price:\[([0-9]+\.[0-9]+)?(\.\.([0-9]+\.[0-9]+))?\]`)
+	var filters []string
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		filter := r.URL.Query().Get("filter")
+		filters = append(filters, filter)
+		min, max := 0.0, math.Inf(1)
+		if m := prices.FindStringSubmatch(filter); m != nil {
+			if m[1] != "" {
+				min, _ = strconv.ParseFloat(m[1], 64)
+			}
+			if m[3] != "" {
+				max, _ = strconv.ParseFloat(m[3], 64)
+			}
+		}
+		total := 0
+		var items []string
+		for _, p := range []float64{1.00, 1.01} {
+			if min <= p && p <= max {
+				total += 11000
+				items = append(items, fmt.Sprintf(`{"itemId": "%.2f"}`, p))
+			}
+		}
+		if strings.Contains(filter, "conditionIds") {
+			total, items = 10, []string{`{"itemId": "new"}`}
+		}
+		fmt.Fprintf(w, `{"total": %d, "itemSummaries": [%s],
+			"refinement": {"conditionDistributions": [{"conditionId": "1000", "matchCount": 10}]}}`,
+			total, strings.Join(items, ","))
+	})
+
+	var ids []string
+	crawler := ebay.Crawler{Browse: client.Buy.Browse, Currency: "USD"}
+	err := crawler.Crawl(context.Background(), nil, func(it ebay.ItemSummary) error {
+		ids = append(ids, it.ItemID)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Contains(t, filters, "price:[1.00..1.00],priceCurrency:USD")
+	assert.Contains(t, filters, "price:[1.01..1.01],priceCurrency:USD")
+	// The condition refinement does not cover the results, the single price partitions are crawled as is.
+	assert.Equal(t, []string{"1.00", "1.01"}, ids)
+}
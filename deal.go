@@ -0,0 +1,255 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DealService handles communication with the Deal API.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/deal/overview.html
+type DealService service
+
+// Several query parameters to use with the DealService methods.
+
+func OptDealCategoryIDs(v string) func(*http.Request) {
+	return optSearch("category_ids")(v)
+}
+
+func OptDealDeliveryCountry(v string) func(*http.Request) {
+	return optSearch("delivery_country")(v)
+}
+
+func OptDealCommissionable(commissionable bool) func(*http.Request) {
+	return optSearch("commissionable")(strconv.FormatBool(commissionable))
+}
+
+func OptDealLimit(limit int) func(*http.Request) {
+	return optSearch("limit")(strconv.Itoa(limit))
+}
+
+func OptDealOffset(offset int) func(*http.Request) {
+	return optSearch("offset")(strconv.Itoa(offset))
+}
+
+// DealMarketingPrice represents the discount applied to the price of a deal.
+type DealMarketingPrice struct {
+	OriginalPrice      Amount `json:"originalPrice"`
+	DiscountPercentage string `json:"discountPercentage"`
+	DiscountAmount     Amount `json:"discountAmount"`
+	PriceTreatment     string `json:"priceTreatment"`
+}
+
+// DealItem represents an item on sale as part of eBay Deals.
+type DealItem struct {
+	ItemID       string `json:"itemId"`
+	LegacyItemID string `json:"legacyItemId"`
+	ItemGroupID  string `json:"itemGroupId"`
+	Title        string `json:"title"`
+	Image        struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"image"`
+	AdditionalImages []struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"additionalImages"`
+	Price                    Amount             `json:"price"`
+	MarketingPrice           DealMarketingPrice `json:"marketingPrice"`
+	CategoryID               string             `json:"categoryId"`
+	CategoryAncestorIDs      []string           `json:"categoryAncestorIds"`
+	Epid                     string             `json:"epid"`
+	DealStartDate            time.Time          `json:"dealStartDate"`
+	DealEndDate              time.Time          `json:"dealEndDate"`
+	DealWebURL               string             `json:"dealWebUrl"`
+	DealAffiliateWebURL      string             `json:"dealAffiliateWebUrl"`
+	QualifiedForFreeShipping bool               `json:"qualifiedForFreeShipping"`
+	ShippingOptions          []struct {
+		ShippingCostType string `json:"shippingCostType"`
+		ShippingCost     Amount `json:"shippingCost"`
+	} `json:"shippingOptions"`
+	UnitPrice             Amount `json:"unitPrice"`
+	UnitPricingMeasure    string `json:"unitPricingMeasure"`
+	EnergyEfficiencyClass string `json:"energyEfficiencyClass"`
+}
+
+// DealItems represents a page of deal items.
+type DealItems struct {
+	Href      string     `json:"href"`
+	Total     int        `json:"total"`
+	Next      string     `json:"next"`
+	Prev      string     `json:"prev"`
+	Limit     int        `json:"limit"`
+	Offset    int        `json:"offset"`
+	DealItems []DealItem `json:"dealItems"`
+}
+
+// GetDealItems retrieves the items on sale as part of eBay Deals.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/deal/resources/deal_item/methods/getDealItems
+func (s *DealService) GetDealItems(ctx context.Context, marketplaceID string, opts ...Opt) (DealItems, error) {
+	opts = append(opts[:len(opts):len(opts)], OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, "buy/deal/v1/deal_item", nil, opts...)
+	if err != nil {
+		return DealItems{}, err
+	}
+	var items DealItems
+	return items, s.client.Do(ctx, req, &items)
+}
+
+// GetDealItemsPages retrieves the items on sale as part of eBay Deals and calls fn for each
+// page of results. See BrowseService.SearchPages.
+func (s *DealService) GetDealItemsPages(ctx context.Context, marketplaceID string, fn func(DealItems) error, opts ...Opt) error {
+	return searchPages(opts, func(opts []Opt) (searchPage, error) {
+		items, err := s.GetDealItems(ctx, marketplaceID, opts...)
+		if err != nil {
+			return searchPage{}, err
+		}
+		return searchPage{items.Offset, items.Limit, items.Total, len(items.DealItems), items.Next}, fn(items)
+	})
+}
+
+// DealTerms represents the terms of an event or coupon.
+type DealTerms struct {
+	MaxAmount Amount `json:"maxAmount"`
+	MinAmount Amount `json:"minAmount"`
+	Text      string `json:"text"`
+}
+
+// Event represents an eBay sales event.
+// The event is live between StartDate and EndDate.
+type Event struct {
+	EventID     string `json:"eventId"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Images      []struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"images"`
+	StartDate            time.Time `json:"startDate"`
+	EndDate              time.Time `json:"endDate"`
+	EventWebURL          string    `json:"eventWebUrl"`
+	EventAffiliateWebURL string    `json:"eventAffiliateWebUrl"`
+	ApplicableCoupons    []struct {
+		RedemptionCode string    `json:"redemptionCode"`
+		Terms          DealTerms `json:"terms"`
+	} `json:"applicableCoupons"`
+	Terms DealTerms `json:"terms"`
+}
+
+// Live reports whether the event is running at t.
+func (e Event) Live(t time.Time) bool {
+	return !t.Before(e.StartDate) && t.Before(e.EndDate)
+}
+
+// Events represents a page of sales events.
+type Events struct {
+	Href   string  `json:"href"`
+	Total  int     `json:"total"`
+	Next   string  `json:"next"`
+	Prev   string  `json:"prev"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+	Events []Event `json:"events"`
+}
+
+// GetEvents retrieves the eBay sales events.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/deal/resources/event/methods/getEvents
+func (s *DealService) GetEvents(ctx context.Context, marketplaceID string, opts ...Opt) (Events, error) {
+	opts = append(opts[:len(opts):len(opts)], OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, "buy/deal/v1/event", nil, opts...)
+	if err != nil {
+		return Events{}, err
+	}
+	var events Events
+	return events, s.client.Do(ctx, req, &events)
+}
+
+// GetEventsPages retrieves the eBay sales events and calls fn for each page of results.
+// See BrowseService.SearchPages.
+func (s *DealService) GetEventsPages(ctx context.Context, marketplaceID string, fn func(Events) error, opts ...Opt) error {
+	return searchPages(opts, func(opts []Opt) (searchPage, error) {
+		events, err := s.GetEvents(ctx, marketplaceID, opts...)
+		if err != nil {
+			return searchPage{}, err
+		}
+		return searchPage{events.Offset, events.Limit, events.Total, len(events.Events), events.Next}, fn(events)
+	})
+}
+
+// GetEvent retrieves an eBay sales event.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/deal/resources/event/methods/getEvent
+func (s *DealService) GetEvent(ctx context.Context, eventID, marketplaceID string, opts ...Opt) (Event, error) {
+	u := fmt.Sprintf("buy/deal/v1/event/%s", eventID)
+	opts = append(opts, OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return Event{}, err
+	}
+	var e Event
+	return e, s.client.Do(ctx, req, &e)
+}
+
+// EventItem represents an item on sale as part of a sales event.
+type EventItem struct {
+	EventID      string `json:"eventId"`
+	ItemID       string `json:"itemId"`
+	LegacyItemID string `json:"legacyItemId"`
+	ItemGroupID  string `json:"itemGroupId"`
+	Title        string `json:"title"`
+	Image        struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"image"`
+	AdditionalImages []struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"additionalImages"`
+	Price               Amount             `json:"price"`
+	MarketingPrice      DealMarketingPrice `json:"marketingPrice"`
+	CategoryID          string             `json:"categoryId"`
+	CategoryAncestorIDs []string           `json:"categoryAncestorIds"`
+	ItemWebURL          string             `json:"itemWebUrl"`
+	ItemAffiliateWebURL string             `json:"itemAffiliateWebUrl"`
+	ShippingOptions     []struct {
+		ShippingCostType string `json:"shippingCostType"`
+		ShippingCost     Amount `json:"shippingCost"`
+	} `json:"shippingOptions"`
+}
+
+// EventItems represents a page of sales event items.
+type EventItems struct {
+	Href       string      `json:"href"`
+	Total      int         `json:"total"`
+	Next       string      `json:"next"`
+	Prev       string      `json:"prev"`
+	Limit      int         `json:"limit"`
+	Offset     int         `json:"offset"`
+	EventItems []EventItem `json:"eventItems"`
+}
+
+// GetEventItems retrieves the items on sale as part of sales events.
+// eventIDs is a comma separated list of event IDs.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/deal/resources/event_item/methods/getEventItems
+func (s *DealService) GetEventItems(ctx context.Context, eventIDs, marketplaceID string, opts ...Opt) (EventItems, error) {
+	opts = append(opts[:len(opts):len(opts)], optSearchSet("event_ids", eventIDs), OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, "buy/deal/v1/event_item", nil, opts...)
+	if err != nil {
+		return EventItems{}, err
+	}
+	var items EventItems
+	return items, s.client.Do(ctx, req, &items)
+}
+
+// GetEventItemsPages retrieves the items on sale as part of sales events and calls fn for
+// each page of results. See BrowseService.SearchPages.
+func (s *DealService) GetEventItemsPages(ctx context.Context, eventIDs, marketplaceID string, fn func(EventItems) error, opts ...Opt) error {
+	return searchPages(opts, func(opts []Opt) (searchPage, error) {
+		items, err := s.GetEventItems(ctx, eventIDs, marketplaceID, opts...)
+		if err != nil {
+			return searchPage{}, err
+		}
+		return searchPage{items.Offset, items.Limit, items.Total, len(items.EventItems), items.Next}, fn(items)
+	})
+}
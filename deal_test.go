@@ -0,0 +1,88 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDealItemsPages(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/deal/v1/deal_item", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		assert.Equal(t, "625", r.URL.Query().Get("category_ids"))
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"total": 2, "limit": 1, "offset": 0, "next": "next", "dealItems": [{"itemId": "1",
+				"price": {"value": "8.00", "currency": "USD"},
+				"marketingPrice": {"originalPrice": {"value": "10.00", "currency": "USD"}, "discountPercentage": "20"}}]}`)
+		case "1":
+			fmt.Fprint(w, `{"total": 2, "limit": 1, "offset": 1, "dealItems": [{"itemId": "2"}]}`)
+		default:
+			t.Fatalf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var items []ebay.DealItem
+	err := client.Buy.Deal.GetDealItemsPages(context.Background(), ebay.BuyMarketplaceUSA, func(page ebay.DealItems) error {
+		items = append(items, page.DealItems...)
+		return nil
+	}, ebay.OptDealCategoryIDs("625"))
+	assert.Nil(t, err)
+	assert.Len(t, items, 2)
+	assert.Equal(t, ebay.Amount{Value: "10.00", Currency: "USD"}, items[0].MarketingPrice.OriginalPrice)
+	assert.Equal(t, "20", items[0].MarketingPrice.DiscountPercentage)
+}
+
+func TestGetEvent(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/deal/v1/event/event1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"eventId": "event1", "startDate": "2019-01-01T00:00:00.000Z", "endDate": "2019-01-08T00:00:00.000Z",
+			"terms": {"minAmount": {"value": "25.00", "currency": "USD"}}}`)
+	})
+
+	e, err := client.Buy.Deal.GetEvent(context.Background(), "event1", ebay.BuyMarketplaceUSA)
+	assert.Nil(t, err)
+	assert.Equal(t, ebay.Amount{Value: "25.00", Currency: "USD"}, e.Terms.MinAmount)
+	assert.False(t, e.Live(time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, e.Live(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, e.Live(time.Date(2019, 1, 8, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestGetEventItems(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/deal/v1/event_item", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, "event1,event2", r.URL.Query().Get("event_ids"))
+		assert.Equal(t, "US", r.URL.Query().Get("delivery_country"))
+		fmt.Fprint(w, `{"total": 1, "limit": 20, "offset": 0, "eventItems": [{"eventId": "event1", "itemId": "1"}]}`)
+	})
+
+	var items []ebay.EventItem
+	err := client.Buy.Deal.GetEventItemsPages(context.Background(), "event1,event2", ebay.BuyMarketplaceUSA, func(page ebay.EventItems) error {
+		items = append(items, page.EventItems...)
+		return nil
+	}, ebay.OptDealDeliveryCountry("US"))
+	assert.Nil(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "event1", items[0].EventID)
+}
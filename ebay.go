@@ -18,6 +18,10 @@ import (
 const (
 	BaseURL        = "https://api.ebay.com/"
 	SandboxBaseURL = "https://api.sandbox.ebay.com/"
+
+	// Some APIs, such as the Identity API, are served by a different host.
+	APIZBaseURL        = "https://apiz.ebay.com/"
+	SandboxAPIZBaseURL = "https://apiz.sandbox.ebay.com/"
 )
 
 // Some eBay API scopes.
@@ -26,56 +30,94 @@ const (
 const (
 	ScopeRoot            = "https://api.ebay.com/oauth/api_scope"
 	ScopeBuyOfferAuction = "https://api.ebay.com/oauth/api_scope/buy.offer.auction"
+	ScopeBuyGuestOrder   = "https://api.ebay.com/oauth/api_scope/buy.guest.order"
+	ScopeBuyOrder        = "https://api.ebay.com/oauth/api_scope/buy.order"
+	// ScopeBuyShoppingCart is required by the shopping cart methods of BrowseService
+	// and must be granted by the user through the authorization code grant.
+	ScopeBuyShoppingCart        = "https://api.ebay.com/oauth/api_scope/buy.shopping.cart"
+	ScopeBuyMarketplaceInsights = "https://api.ebay.com/oauth/api_scope/buy.marketplace.insights"
+	ScopeBuyDeal                = "https://api.ebay.com/oauth/api_scope/buy.deal"
+	ScopeCommerceCatalog        = "https://api.ebay.com/oauth/api_scope/commerce.catalog.readonly"
+	ScopeCommerceIdentity       = "https://api.ebay.com/oauth/api_scope/commerce.identity.readonly"
 )
 
 // BuyAPI regroups the eBay Buy APIs.
 //
 // eBay API docs: https://developer.ebay.com/api-docs/buy/static/buy-landing.html
 type BuyAPI struct {
-	Browse *BrowseService
-	Offer  *OfferService
+	Browse              *BrowseService
+	Offer               *OfferService
+	Order               *OrderService
+	Feed                *FeedService
+	MarketplaceInsights *MarketplaceInsightsService
+	Marketing           *MarketingService
+	Deal                *DealService
+}
+
+// CommerceAPI regroups the eBay Commerce APIs.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/static/commerce-landing.html
+type CommerceAPI struct {
+	Taxonomy    *TaxonomyService
+	Catalog     *CatalogService
+	Identity    *IdentityService
+	Translation *TranslationService
 }
 
 // Client manages communication with the eBay API.
 type Client struct {
 	client  *http.Client // Used to make actual API requests.
 	baseURL *url.URL     // Base URL for API requests.
+	apizURL *url.URL     // Base URL for API requests served by the apiz host.
 
 	// eBay APIs.
-	Buy BuyAPI
+	Buy      BuyAPI
+	Commerce CommerceAPI
 }
 
 // NewClient returns a new eBay API client.
 // If a nil httpClient is provided, http.DefaultClient will be used.
 func NewClient(httpclient *http.Client) *Client {
-	return newClient(httpclient, BaseURL)
+	return newClient(httpclient, BaseURL, APIZBaseURL)
 }
 
 // NewSandboxClient returns a new eBay sandbox API client.
 // If a nil httpClient is provided, http.DefaultClient will be used.
 func NewSandboxClient(httpclient *http.Client) *Client {
-	return newClient(httpclient, SandboxBaseURL)
+	return newClient(httpclient, SandboxBaseURL, SandboxAPIZBaseURL)
 }
 
 // NewCustomClient returns a new custom eBay API client.
-// BaseURL should have a trailing slash.
+// BaseURL should have a trailing slash. It is also used for the APIs served by the apiz host.
 // If a nil httpClient is provided, http.DefaultClient will be used.
 func NewCustomClient(httpclient *http.Client, baseURL string) (*Client, error) {
 	if !strings.HasSuffix(baseURL, "/") {
 		return nil, fmt.Errorf("BaseURL %s must have a trailing slash", baseURL)
 	}
-	return newClient(httpclient, baseURL), nil
+	return newClient(httpclient, baseURL, baseURL), nil
 }
 
-func newClient(httpclient *http.Client, baseURL string) *Client {
+func newClient(httpclient *http.Client, baseURL, apizURL string) *Client {
 	if httpclient == nil {
 		httpclient = http.DefaultClient
 	}
-	url, _ := url.Parse(baseURL)
-	c := &Client{client: httpclient, baseURL: url}
+	base, _ := url.Parse(baseURL)
+	apiz, _ := url.Parse(apizURL)
+	c := &Client{client: httpclient, baseURL: base, apizURL: apiz}
 	c.Buy = BuyAPI{
-		Browse: (*BrowseService)(&service{c}),
-		Offer:  (*OfferService)(&service{c}),
+		Browse:              (*BrowseService)(&service{c}),
+		Offer:               (*OfferService)(&service{c}),
+		Order:               (*OrderService)(&service{c}),
+		Feed:                (*FeedService)(&service{c}),
+		MarketplaceInsights: (*MarketplaceInsightsService)(&service{c}),
+		Marketing:           (*MarketingService)(&service{c}),
+		Deal:                (*DealService)(&service{c}),
+	}
+	c.Commerce = CommerceAPI{
+		Taxonomy:    (*TaxonomyService)(&service{c}),
+		Catalog:     (*CatalogService)(&service{c}),
+		Identity:    (*IdentityService)(&service{c}),
+		Translation: (*TranslationService)(&service{c}),
 	}
 	return c
 }
@@ -119,21 +161,32 @@ func (c *Client) NewRequest(method, url string, body interface{}, opts ...Opt) (
 
 // Do sends an API request and stores the JSON decoded value into v.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) error {
-	dump, _ := httputil.DumpRequest(req, true)
-	resp, err := c.client.Do(req.WithContext(ctx))
+	resp, err := c.do(ctx, req)
 	if err != nil {
-		return errors.WithStack(err)
-	}
-	defer resp.Body.Close()
-	if err := CheckResponse(req, resp, string(dump)); err != nil {
 		return err
 	}
-	if v == nil {
+	defer resp.Body.Close()
+	if v == nil || resp.StatusCode == http.StatusNoContent {
 		return nil
 	}
 	return errors.WithStack(json.NewDecoder(resp.Body).Decode(v))
 }
 
+// do sends an API request and returns the response if its status code is 2xx.
+// The caller must close the response body.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	dump, _ := httputil.DumpRequest(req, true)
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := CheckResponse(req, resp, string(dump)); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Error describes one error caused by an eBay API request.
 //
 // eBay API docs: https://developer.ebay.com/api-docs/static/handling-error-messages.html
@@ -176,20 +229,32 @@ func CheckResponse(req *http.Request, resp *http.Response, dump string) error {
 	return errorData
 }
 
+// ErrorCoder is implemented by errors carrying eBay error codes.
+type ErrorCoder interface {
+	ErrorCodes() []int
+}
+
+// ErrorCodes allows ErrorData to implement ErrorCoder.
+func (e *ErrorData) ErrorCodes() []int {
+	codes := make([]int, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		codes = append(codes, err.ErrorID)
+	}
+	return codes
+}
+
 // IsError allows to check if err contains specific error codes returned by the eBay API.
+// It matches the codes of any ErrorCoder, such as *ErrorData.
 //
 // eBay API docs: https://developer.ebay.com/devzone/xml/docs/Reference/ebay/Errors/errormessages.htm
 func IsError(err error, codes ...int) bool {
-	if err == nil {
-		return false
-	}
-	errData, ok := err.(*ErrorData)
+	coder, ok := err.(ErrorCoder)
 	if !ok {
 		return false
 	}
-	for _, e := range errData.Errors {
+	for _, got := range coder.ErrorCodes() {
 		for _, code := range codes {
-			if e.ErrorID == code {
+			if got == code {
 				return true
 			}
 		}
@@ -0,0 +1,229 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FeedService handles communication with the Feed API.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/feed/overview.html
+type FeedService service
+
+// Valid values for FeedFile.Type.
+const (
+	FeedTypeItem         = "item"
+	FeedTypeItemSnapshot = "item_snapshot"
+)
+
+// Valid values for the "feed_scope" query parameter of item feeds.
+const (
+	FeedScopeNewlyListed = "NEWLY_LISTED"
+	FeedScopeAllActive   = "ALL_ACTIVE"
+)
+
+// FeedChunkSize is the default number of bytes requested per chunk.
+const FeedChunkSize = 10 << 20
+
+// FeedFile identifies a feed file.
+type FeedFile struct {
+	// Type is FeedTypeItem or FeedTypeItemSnapshot.
+	Type          string
+	MarketplaceID string
+	CategoryID    string
+
+	// Scope is required by item feeds.
+	Scope string
+
+	// Date is the day of item feeds and the hour of item_snapshot feeds, in UTC.
+	// Weekly ALL_ACTIVE item feeds have no date.
+	Date time.Time
+}
+
+func (f FeedFile) url() (string, error) {
+	params := url.Values{}
+	params.Set("category_id", f.CategoryID)
+	switch f.Type {
+	case FeedTypeItem:
+		params.Set("feed_scope", f.Scope)
+		if !f.Date.IsZero() {
+			params.Set("date", f.Date.UTC().Format("20060102"))
+		}
+	case FeedTypeItemSnapshot:
+		params.Set("snapshot_date", f.Date.UTC().Format("2006-01-02T15:00:00.000Z"))
+	default:
+		return "", errors.Errorf("invalid feed type %q", f.Type)
+	}
+	return fmt.Sprintf("buy/feed/v1_beta/%s?%s", f.Type, params.Encode()), nil
+}
+
+// FeedChunk represents a range of bytes of a feed file.
+type FeedChunk struct {
+	Data  []byte
+	Start int64
+	// Size is the total size of the feed file.
+	Size int64
+}
+
+// GetChunk retrieves at most length bytes of a feed file starting at start.
+// start must be less than the size of the file.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/feed/resources/item/methods/getItemFeed
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/feed/resources/item_snapshot/methods/getItemSnapshotFeed
+func (s *FeedService) GetChunk(ctx context.Context, file FeedFile, start, length int64, opts ...Opt) (FeedChunk, error) {
+	resp, err := s.openChunk(ctx, file, start, length, opts)
+	if err != nil {
+		return FeedChunk{}, err
+	}
+	return readChunk(resp, start)
+}
+
+// openChunk requests a chunk of a feed file. The whole file is returned with a 200 status code
+// if the server ignores the range, which is only accepted when start is 0.
+func (s *FeedService) openChunk(ctx context.Context, file FeedFile, start, length int64, opts []Opt) (*http.Response, error) {
+	u, err := file.url()
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, OptBuyMarketplace(file.MarketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, start+length-1))
+	resp, err := s.client.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusPartialContent && start != 0 {
+		resp.Body.Close()
+		return nil, errors.Errorf("expected partial content, got status %d", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// readChunk reads a response returned by openChunk.
+func readChunk(resp *http.Response, start int64) (FeedChunk, error) {
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return FeedChunk{}, errors.WithStack(err)
+	}
+	chunk := FeedChunk{Data: data, Start: start}
+	if resp.StatusCode != http.StatusPartialContent {
+		chunk.Size = int64(len(data))
+		return chunk, nil
+	}
+	var first, last int64
+	cr := resp.Header.Get("Content-Range")
+	if _, err := fmt.Sscanf(cr, "bytes %d-%d/%d", &first, &last, &chunk.Size); err != nil {
+		return FeedChunk{}, errors.Errorf("invalid Content-Range %q", cr)
+	}
+	if first != start || last-first+1 != int64(len(data)) {
+		return FeedChunk{}, errors.Errorf("Content-Range %q does not match the %d bytes received from %d", cr, len(data), start)
+	}
+	return chunk, nil
+}
+
+// FeedDownloader downloads feed files in chunks.
+type FeedDownloader struct {
+	Feed *FeedService
+
+	// ChunkSize is the number of bytes requested per chunk. Defaults to FeedChunkSize.
+	ChunkSize int64
+
+	// Parallel is the number of chunks downloaded concurrently. Defaults to 1.
+	// Up to Parallel chunks are held in memory.
+	Parallel int
+}
+
+// Download writes a feed file to w, starting at offset, and returns the offset reached.
+// An interrupted download is resumed by calling Download again with the returned offset
+// and a writer positioned at the end of the bytes already written.
+// Resuming a download that already reached the end of the file writes nothing.
+func (d *FeedDownloader) Download(ctx context.Context, w io.Writer, file FeedFile, offset int64, opts ...Opt) (int64, error) {
+	chunkSize := d.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = FeedChunkSize
+	}
+	parallel := d.Parallel
+	if parallel <= 0 {
+		parallel = 1
+	}
+	resp, err := d.Feed.openChunk(ctx, file, offset, chunkSize, opts)
+	if err != nil {
+		if offset > 0 && feedComplete(err, offset) {
+			return offset, nil
+		}
+		return offset, err
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		// The server sent the whole file, it is streamed to w.
+		defer resp.Body.Close()
+		n, err := io.Copy(w, resp.Body)
+		return offset + n, errors.WithStack(err)
+	}
+	first, err := readChunk(resp, offset)
+	if err != nil {
+		return offset, err
+	}
+	if _, err := w.Write(first.Data); err != nil {
+		return offset, errors.WithStack(err)
+	}
+	offset += int64(len(first.Data))
+	for offset < first.Size {
+		var starts []int64
+		for start := offset; start < first.Size && len(starts) < parallel; start += chunkSize {
+			starts = append(starts, start)
+		}
+		chunks := make([]FeedChunk, len(starts))
+		errs := make([]error, len(starts))
+		var wg sync.WaitGroup
+		for i, start := range starts {
+			wg.Add(1)
+			go func(i int, start int64) {
+				defer wg.Done()
+				chunks[i], errs[i] = d.Feed.GetChunk(ctx, file, start, chunkSize, opts...)
+			}(i, start)
+		}
+		wg.Wait()
+		for i, chunk := range chunks {
+			if errs[i] != nil {
+				return offset, errs[i]
+			}
+			if chunk.Start != offset {
+				// A previous chunk was shorter than requested, the next batch starts at offset.
+				break
+			}
+			if len(chunk.Data) == 0 {
+				return offset, errors.WithStack(io.ErrUnexpectedEOF)
+			}
+			if _, err := w.Write(chunk.Data); err != nil {
+				return offset, errors.WithStack(err)
+			}
+			offset += int64(len(chunk.Data))
+		}
+	}
+	return offset, nil
+}
+
+// feedComplete reports whether err is the range error returned when resuming a download at the end of the file.
+func feedComplete(err error, offset int64) bool {
+	errData, ok := err.(*ErrorData)
+	if !ok || errData.response.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		return false
+	}
+	// Without the size of the file, the offset may be past its end.
+	var size int64
+	_, err = fmt.Sscanf(errData.response.Header.Get("Content-Range"), "bytes */%d", &size)
+	return err == nil && size == offset
+}
@@ -0,0 +1,135 @@
+package ebay_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func serveFeed(t *testing.T, content []byte, failAt int64) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		if failAt >= 0 && strings.HasPrefix(r.Header.Get("Range"), "bytes="+strconv.FormatInt(failAt, 10)+"-") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+	}
+}
+
+func TestFeedDownload(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	mux.HandleFunc("/buy/feed/v1_beta/item", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "NEWLY_LISTED", r.URL.Query().Get("feed_scope"))
+		assert.Equal(t, "625", r.URL.Query().Get("category_id"))
+		assert.Equal(t, "20190103", r.URL.Query().Get("date"))
+		serveFeed(t, content, -1)(w, r)
+	})
+
+	file := ebay.FeedFile{
+		Type:          ebay.FeedTypeItem,
+		MarketplaceID: ebay.BuyMarketplaceUSA,
+		CategoryID:    "625",
+		Scope:         ebay.FeedScopeNewlyListed,
+		Date:          time.Date(2019, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+	for _, parallel := range []int{1, 3} {
+		var buf bytes.Buffer
+		d := ebay.FeedDownloader{Feed: client.Buy.Feed, ChunkSize: 5, Parallel: parallel}
+		n, err := d.Download(context.Background(), &buf, file, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(len(content)), n)
+		assert.Equal(t, string(content), buf.String())
+	}
+}
+
+func TestFeedDownloadResume(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	var fail int32 = 1
+	mux.HandleFunc("/buy/feed/v1_beta/item_snapshot", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2019-01-03T05:00:00.000Z", r.URL.Query().Get("snapshot_date"))
+		failAt := int64(-1)
+		if atomic.LoadInt32(&fail) == 1 {
+			failAt = 20
+		}
+		serveFeed(t, content, failAt)(w, r)
+	})
+
+	file := ebay.FeedFile{
+		Type:          ebay.FeedTypeItemSnapshot,
+		MarketplaceID: ebay.BuyMarketplaceUSA,
+		CategoryID:    "625",
+		Date:          time.Date(2019, 1, 3, 5, 30, 0, 0, time.UTC),
+	}
+	var buf bytes.Buffer
+	d := ebay.FeedDownloader{Feed: client.Buy.Feed, ChunkSize: 10, Parallel: 2}
+	n, err := d.Download(context.Background(), &buf, file, 0)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(20), n)
+	assert.Equal(t, string(content[:20]), buf.String())
+
+	atomic.StoreInt32(&fail, 0)
+	n, err = d.Download(context.Background(), &buf, file, n)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), n)
+	assert.Equal(t, string(content), buf.String())
+
+	// Resuming a complete download.
+	n, err = d.Download(context.Background(), &buf, file, n)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), n)
+	assert.Equal(t, string(content), buf.String())
+}
+
+func TestFeedDownloadWholeFile(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	mux.HandleFunc("/buy/feed/v1_beta/item_snapshot", func(w http.ResponseWriter, r *http.Request) {
+		// The range is ignored.
+		w.Write(content)
+	})
+
+	file := ebay.FeedFile{Type: ebay.FeedTypeItemSnapshot, MarketplaceID: ebay.BuyMarketplaceUSA, CategoryID: "625",
+		Date: time.Date(2019, 1, 3, 5, 30, 0, 0, time.UTC)}
+	var buf bytes.Buffer
+	d := ebay.FeedDownloader{Feed: client.Buy.Feed, ChunkSize: 10}
+	n, err := d.Download(context.Background(), &buf, file, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), n)
+	assert.Equal(t, string(content), buf.String())
+}
+
+func TestFeedDownloadPastEnd(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/feed/v1_beta/item_snapshot", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+	})
+
+	file := ebay.FeedFile{Type: ebay.FeedTypeItemSnapshot, MarketplaceID: ebay.BuyMarketplaceUSA, CategoryID: "625",
+		Date: time.Date(2019, 1, 3, 5, 30, 0, 0, time.UTC)}
+	d := ebay.FeedDownloader{Feed: client.Buy.Feed}
+	// The size of the file is unknown, the offset can't be trusted.
+	_, err := d.Download(context.Background(), &bytes.Buffer{}, file, 100)
+	assert.NotNil(t, err)
+}
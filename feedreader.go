@@ -0,0 +1,243 @@
+package ebay
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FeedItem represents a row of an item or item_snapshot feed file.
+// Columns without a matching field are kept in Extras by column name.
+type FeedItem struct {
+	ItemID             string
+	SellerItemRevision string
+	Title              string
+	Image              Image
+	AdditionalImages   []Image
+	CategoryID         string
+	CategoryPath       string
+	BuyingOptions      []string
+	Seller             Seller
+	Gtin               string
+	Brand              string
+	Mpn                string
+	Epid               string
+	ConditionID        string
+	Condition          string
+	Price              Amount
+	PrimaryItemGroup   struct {
+		ItemGroupID   string `json:"itemGroupId"`
+		ItemGroupType string `json:"itemGroupType"`
+	}
+	ItemEndDate         time.Time
+	ItemLocation        ItemLocation
+	LocalizedAspects    []LocalizedAspect
+	ItemWebURL          string
+	ItemAffiliateWebURL string
+
+	Extras map[string]string
+}
+
+// feedColumns maps the known feed columns to FeedItem fields.
+var feedColumns = map[string]func(it *FeedItem, v string) error{
+	"itemId":               func(it *FeedItem, v string) error { it.ItemID = v; return nil },
+	"sellerItemRevision":   func(it *FeedItem, v string) error { it.SellerItemRevision = v; return nil },
+	"title":                func(it *FeedItem, v string) error { it.Title = v; return nil },
+	"imageUrl":             func(it *FeedItem, v string) error { it.Image.ImageURL = v; return nil },
+	"categoryId":           func(it *FeedItem, v string) error { it.CategoryID = v; return nil },
+	"category":             func(it *FeedItem, v string) error { it.CategoryPath = v; return nil },
+	"sellerUsername":       func(it *FeedItem, v string) error { it.Seller.Username = v; return nil },
+	"gtin":                 func(it *FeedItem, v string) error { it.Gtin = v; return nil },
+	"brand":                func(it *FeedItem, v string) error { it.Brand = v; return nil },
+	"mpn":                  func(it *FeedItem, v string) error { it.Mpn = v; return nil },
+	"epid":                 func(it *FeedItem, v string) error { it.Epid = v; return nil },
+	"conditionId":          func(it *FeedItem, v string) error { it.ConditionID = v; return nil },
+	"condition":            func(it *FeedItem, v string) error { it.Condition = v; return nil },
+	"priceCurrency":        func(it *FeedItem, v string) error { it.Price.Currency = v; return nil },
+	"primaryItemGroupId":   func(it *FeedItem, v string) error { it.PrimaryItemGroup.ItemGroupID = v; return nil },
+	"primaryItemGroupType": func(it *FeedItem, v string) error { it.PrimaryItemGroup.ItemGroupType = v; return nil },
+	"itemLocationCountry":  func(it *FeedItem, v string) error { it.ItemLocation.Country = v; return nil },
+	"itemWebUrl":           func(it *FeedItem, v string) error { it.ItemWebURL = v; return nil },
+	"itemAffiliateWebUrl":  func(it *FeedItem, v string) error { it.ItemAffiliateWebURL = v; return nil },
+	"sellerFeedbackPercentage": func(it *FeedItem, v string) error {
+		it.Seller.FeedbackPercentage = v
+		return nil
+	},
+	"sellerFeedbackScore": func(it *FeedItem, v string) error {
+		if v == "" {
+			return nil
+		}
+		score, err := strconv.Atoi(v)
+		it.Seller.FeedbackScore = score
+		return err
+	},
+	"priceValue": func(it *FeedItem, v string) error {
+		if v != "" {
+			if _, err := ParseDecimal(v); err != nil {
+				return err
+			}
+		}
+		it.Price.Value = v
+		return nil
+	},
+	"itemEndDate": func(it *FeedItem, v string) error {
+		if v == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		it.ItemEndDate = t
+		return err
+	},
+	"buyingOptions": func(it *FeedItem, v string) error {
+		it.BuyingOptions = splitFeedList(v, "|")
+		return nil
+	},
+	"additionalImageUrls": func(it *FeedItem, v string) error {
+		for _, u := range splitFeedList(v, "|") {
+			it.AdditionalImages = append(it.AdditionalImages, Image{ImageURL: u})
+		}
+		return nil
+	},
+	// localizedAspects is a semicolon separated list of base64 encoded name:value pairs.
+	"localizedAspects": func(it *FeedItem, v string) error {
+		for _, pair := range splitFeedList(v, ";") {
+			parts := strings.SplitN(pair, ":", 2)
+			if len(parts) != 2 {
+				return errors.Errorf("invalid aspect %q", pair)
+			}
+			name, err := base64.StdEncoding.DecodeString(parts[0])
+			if err != nil {
+				return err
+			}
+			value, err := base64.StdEncoding.DecodeString(parts[1])
+			if err != nil {
+				return err
+			}
+			it.LocalizedAspects = append(it.LocalizedAspects, LocalizedAspect{Name: string(name), Value: string(value)})
+		}
+		return nil
+	},
+}
+
+func splitFeedList(v, sep string) []string {
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, sep)
+}
+
+// FeedRowError describes a malformed row of a feed file.
+// Line is the 1-based line number of the row, the header being line 1.
+type FeedRowError struct {
+	Line   int
+	Column string
+	Err    error
+}
+
+func (e *FeedRowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("feed line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("feed line %d: column %s: %v", e.Line, e.Column, e.Err)
+}
+
+// FeedReader reads the rows of a feed file one at a time.
+type FeedReader struct {
+	r      *bufio.Reader
+	gz     *gzip.Reader
+	header []string
+	line   int
+}
+
+// NewFeedReader returns a FeedReader reading a tab separated feed file from r,
+// decompressing it if it is gzipped. The header row is read immediately.
+func NewFeedReader(r io.Reader) (*FeedReader, error) {
+	fr := &FeedReader{r: bufio.NewReaderSize(r, 1<<16)}
+	if magic, _ := fr.r.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(fr.r)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		fr.gz = gz
+		fr.r = bufio.NewReaderSize(gz, 1<<16)
+	}
+	header, err := fr.readLine()
+	if err == io.EOF {
+		return nil, errors.New("feed: missing header")
+	}
+	if err != nil {
+		return nil, err
+	}
+	fr.header = strings.Split(header, "\t")
+	return fr, nil
+}
+
+// Header returns the column names of the feed file.
+func (r *FeedReader) Header() []string {
+	return r.header
+}
+
+func (r *FeedReader) readLine() (string, error) {
+	line, err := r.r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		if err == io.EOF {
+			return "", err
+		}
+		return "", errors.WithStack(err)
+	}
+	r.line++
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// Read returns the next row of the feed file, or io.EOF once all rows were read.
+// Blank lines are skipped. A malformed row returns a *FeedRowError, and reading
+// can continue with the next row.
+func (r *FeedReader) Read() (FeedItem, error) {
+	var line string
+	for line == "" {
+		var err error
+		if line, err = r.readLine(); err != nil {
+			return FeedItem{}, err
+		}
+	}
+	fields := strings.Split(line, "\t")
+	if len(fields) != len(r.header) {
+		return FeedItem{}, &FeedRowError{Line: r.line,
+			Err: errors.Errorf("expected %d columns, got %d", len(r.header), len(fields))}
+	}
+	var it FeedItem
+	for i, v := range fields {
+		column := r.header[i]
+		set, ok := feedColumns[column]
+		if !ok {
+			if it.Extras == nil {
+				it.Extras = map[string]string{}
+			}
+			it.Extras[column] = v
+			continue
+		}
+		if err := set(&it, v); err != nil {
+			return FeedItem{}, &FeedRowError{Line: r.line, Column: column, Err: err}
+		}
+	}
+	return it, nil
+}
+
+// Close releases the resources used to decompress the feed file.
+// It does not close the underlying reader.
+func (r *FeedReader) Close() error {
+	if r.gz == nil {
+		return nil
+	}
+	return errors.WithStack(r.gz.Close())
+}
@@ -0,0 +1,78 @@
+package ebay_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedReader(t *testing.T) {
+	aspects := base64.StdEncoding.EncodeToString([]byte("Color")) + ":" + base64.StdEncoding.EncodeToString([]byte("Red"))
+	rows := []string{
+		"itemId\ttitle\tpriceValue\tpriceCurrency\tcategory\tbuyingOptions\titemEndDate\tlocalizedAspects\tnewColumn",
+		"v1|1|0\tShirt\t9.99\tUSD\tClothing|Shirts\tFIXED_PRICE|BEST_OFFER\t2019-01-03T05:00:00.000Z\t" + aspects + "\tfoo",
+		"",
+		"v1|2|0\tShirt\tnot a price\tUSD\t\t\t\t\tbar",
+		"v1|3|0\ttoo few columns",
+		"v1|4|0\tPants\t\tUSD\t\tAUCTION\t\t\t\r",
+	}
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, _ = io.WriteString(gz, strings.Join(rows, "\n"))
+	assert.Nil(t, gz.Close())
+
+	r, err := ebay.NewFeedReader(&buf)
+	assert.Nil(t, err)
+	defer r.Close()
+	assert.Equal(t, "newColumn", r.Header()[8])
+
+	it, err := r.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, "v1|1|0", it.ItemID)
+	assert.Equal(t, ebay.Amount{Value: "9.99", Currency: "USD"}, it.Price)
+	assert.Equal(t, "Clothing|Shirts", it.CategoryPath)
+	assert.Equal(t, []string{"FIXED_PRICE", "BEST_OFFER"}, it.BuyingOptions)
+	assert.Equal(t, time.Date(2019, 1, 3, 5, 0, 0, 0, time.UTC), it.ItemEndDate)
+	assert.Equal(t, []ebay.LocalizedAspect{{Name: "Color", Value: "Red"}}, it.LocalizedAspects)
+	assert.Equal(t, map[string]string{"newColumn": "foo"}, it.Extras)
+
+	_, err = r.Read()
+	rowErr, ok := err.(*ebay.FeedRowError)
+	assert.True(t, ok)
+	assert.Equal(t, 4, rowErr.Line)
+	assert.Equal(t, "priceValue", rowErr.Column)
+
+	_, err = r.Read()
+	rowErr, ok = err.(*ebay.FeedRowError)
+	assert.True(t, ok)
+	assert.Equal(t, 5, rowErr.Line)
+
+	it, err = r.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, "v1|4|0", it.ItemID)
+	assert.Equal(t, "", it.Extras["newColumn"])
+
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestFeedReaderUncompressed(t *testing.T) {
+	r, err := ebay.NewFeedReader(strings.NewReader("itemId\tsellerFeedbackScore\timageUrl\tadditionalImageUrls\nv1|1|0\t12\ta.jpg\tb.jpg|c.jpg\n"))
+	assert.Nil(t, err)
+	it, err := r.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, ebay.Seller{FeedbackScore: 12}, it.Seller)
+	assert.Equal(t, ebay.Image{ImageURL: "a.jpg"}, it.Image)
+	assert.Equal(t, []ebay.Image{{ImageURL: "b.jpg"}, {ImageURL: "c.jpg"}}, it.AdditionalImages)
+	assert.Nil(t, it.Extras)
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+	assert.Nil(t, r.Close())
+}
@@ -0,0 +1,352 @@
+package ebay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http/httputil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AuditEntry represents a mutating call made, or refused, by an OfferGuard.
+type AuditEntry struct {
+	Time                     time.Time
+	Method                   string
+	ItemID                   string
+	MarketplaceID            string
+	MaxAmount                string
+	Currency                 string
+	UserConsentAdultOnlyItem bool
+	DryRun                   bool
+	// Request is the dump of the HTTP request sent, or that would have been sent in dry-run mode.
+	Request  string
+	ProxyBid ProxyBid
+	Err      error
+}
+
+// AuditLog records the mutating calls made through an OfferGuard.
+type AuditLog interface {
+	Record(AuditEntry) error
+}
+
+// AuditLogFunc allows to use an ordinary function as an AuditLog.
+type AuditLogFunc func(AuditEntry) error
+
+// Record allows AuditLogFunc to implement AuditLog.
+func (f AuditLogFunc) Record(e AuditEntry) error {
+	return f(e)
+}
+
+// GuardError describes a call refused by an OfferGuard.
+type GuardError struct {
+	Message string
+}
+
+func (e *GuardError) Error() string {
+	return "guard: " + e.Message
+}
+
+// GuardBid represents a proxy bid counted in the exposure of an OfferGuard.
+type GuardBid struct {
+	Time     time.Time `json:"time"`
+	Currency string    `json:"currency"`
+	Amount   Decimal   `json:"amount"`
+}
+
+// GuardState represents the exposure tracked by an OfferGuard.
+type GuardState struct {
+	// Outstanding maps item IDs to the proxy bids that were not released.
+	Outstanding map[string]GuardBid `json:"outstanding"`
+	// History lists the exposure added over the last 24 hours, oldest first.
+	History []GuardBid `json:"history"`
+}
+
+func (s *GuardState) clone() *GuardState {
+	c := &GuardState{Outstanding: map[string]GuardBid{}, History: append([]GuardBid(nil), s.History...)}
+	for id, b := range s.Outstanding {
+		c.Outstanding[id] = b
+	}
+	return c
+}
+
+// GuardStore persists the exposure tracked by an OfferGuard.
+type GuardStore interface {
+	// Load returns the saved state, or an empty state if nothing was saved.
+	Load() (GuardState, error)
+	Save(GuardState) error
+}
+
+// FileGuardStore is a GuardStore saving the state as a JSON file at Path.
+type FileGuardStore struct {
+	Path string
+}
+
+// Load allows FileGuardStore to implement GuardStore.
+func (s FileGuardStore) Load() (GuardState, error) {
+	var state GuardState
+	b, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, errors.WithStack(err)
+	}
+	return state, errors.WithStack(json.Unmarshal(b, &state))
+}
+
+// Save allows FileGuardStore to implement GuardStore.
+// The file is replaced atomically.
+func (s FileGuardStore) Save(state GuardState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeFileAtomic(s.Path, b)
+}
+
+// OfferGuard wraps an OfferService to enforce spending limits on proxy bids.
+// Limits are expressed per currency. A nil map or slice means no limit, while a currency
+// missing from a non-nil limit map is refused.
+//
+// The outstanding exposure is the sum of the maximum amounts of the proxy bids placed
+// through the guard that were not released. A new proxy bid on an item replaces the previous one.
+// Proxy bids placed through the guard are sent one at a time.
+//
+// The exposure is kept in memory unless Store is set.
+// In dry-run mode, bids are recorded in a simulated exposure, starting from the actual exposure,
+// so that a dry-run sequence shows when a later bid would exceed a limit.
+type OfferGuard struct {
+	Offer *OfferService
+
+	// MaxBid limits the maximum amount of a single proxy bid.
+	MaxBid map[string]Decimal
+	// DailyLimit limits the exposure added over the last 24 hours.
+	DailyLimit map[string]Decimal
+	// TotalLimit limits the outstanding exposure.
+	TotalLimit map[string]Decimal
+
+	AllowedMarketplaces []string
+	AllowedCurrencies   []string
+
+	// DryRun validates and records the calls without sending them.
+	DryRun bool
+
+	// Audit, if set, records every mutating call.
+	Audit AuditLog
+
+	// Clock defaults to the system clock.
+	Clock Clock
+
+	// Store, if set, persists the exposure so that it survives restarts of the program
+	// and is shared by the guards using the same store. It is loaded before every proxy bid.
+	Store GuardStore
+
+	mu        sync.Mutex
+	live      GuardState
+	simulated *GuardState // Reset when leaving dry-run mode.
+}
+
+// load replaces the live state with the one saved in Store, if any.
+func (g *OfferGuard) load() error {
+	if g.Store == nil {
+		return nil
+	}
+	state, err := g.Store.Load()
+	if err != nil {
+		return err
+	}
+	g.live = state
+	return nil
+}
+
+func (g *OfferGuard) save() error {
+	if g.Store == nil {
+		return nil
+	}
+	return g.Store.Save(g.live)
+}
+
+// PlaceProxyBid checks the proxy bid against the guard limits then places it, unless in dry-run mode.
+// Refused bids return a *GuardError. See OfferService.PlaceProxyBid.
+func (g *OfferGuard) PlaceProxyBid(ctx context.Context, itemID, marketplaceID, maxAmount, currency string, userConsentAdultOnlyItem bool, opts ...Opt) (ProxyBid, error) {
+	clock := clockOrSystem(g.Clock)
+	entry := AuditEntry{
+		Time:                     clock.Now(),
+		Method:                   "PlaceProxyBid",
+		ItemID:                   itemID,
+		MarketplaceID:            marketplaceID,
+		MaxAmount:                maxAmount,
+		Currency:                 currency,
+		UserConsentAdultOnlyItem: userConsentAdultOnlyItem,
+		DryRun:                   g.DryRun,
+	}
+	bid, err := g.placeProxyBid(ctx, &entry, opts)
+	entry.ProxyBid, entry.Err = bid, err
+	if g.Audit != nil {
+		if auditErr := g.Audit.Record(entry); auditErr != nil && err == nil {
+			err = auditErr
+		}
+	}
+	return bid, err
+}
+
+func (g *OfferGuard) placeProxyBid(ctx context.Context, entry *AuditEntry, opts []Opt) (ProxyBid, error) {
+	req, err := g.Offer.newPlaceProxyBidRequest(entry.ItemID, entry.MarketplaceID, entry.MaxAmount,
+		entry.Currency, entry.UserConsentAdultOnlyItem, opts...)
+	if err != nil {
+		return ProxyBid{}, err
+	}
+	dump, _ := httputil.DumpRequest(req, true)
+	entry.Request = string(dump)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.DryRun || g.simulated == nil {
+		if err := g.load(); err != nil {
+			return ProxyBid{}, err
+		}
+	}
+	exposure := &g.live
+	if g.DryRun {
+		if g.simulated == nil {
+			g.simulated = g.live.clone()
+		}
+		exposure = g.simulated
+	} else {
+		g.simulated = nil
+	}
+	amount, err := g.check(exposure, entry)
+	if err != nil {
+		return ProxyBid{}, err
+	}
+	if g.DryRun {
+		exposure.record(entry.Time, entry.ItemID, entry.Currency, amount)
+		return ProxyBid{}, nil
+	}
+	var bid ProxyBid
+	if err := g.Offer.client.Do(ctx, req, &bid); err != nil {
+		return ProxyBid{}, err
+	}
+	exposure.record(entry.Time, entry.ItemID, entry.Currency, amount)
+	return bid, g.save()
+}
+
+// check returns the parsed amount of the bid or a *GuardError.
+func (g *OfferGuard) check(exposure *GuardState, entry *AuditEntry) (Decimal, error) {
+	if g.AllowedMarketplaces != nil && !contains(g.AllowedMarketplaces, entry.MarketplaceID) {
+		return Decimal{}, &GuardError{fmt.Sprintf("marketplace %s is not allowed", entry.MarketplaceID)}
+	}
+	if g.AllowedCurrencies != nil && !contains(g.AllowedCurrencies, entry.Currency) {
+		return Decimal{}, &GuardError{fmt.Sprintf("currency %s is not allowed", entry.Currency)}
+	}
+	for _, limits := range []map[string]Decimal{g.MaxBid, g.DailyLimit, g.TotalLimit} {
+		if _, ok := limits[entry.Currency]; limits != nil && !ok {
+			return Decimal{}, &GuardError{fmt.Sprintf("currency %s has no limit", entry.Currency)}
+		}
+	}
+	amount, err := ParseDecimal(entry.MaxAmount)
+	if err != nil || amount.Sign() <= 0 {
+		return Decimal{}, &GuardError{fmt.Sprintf("invalid amount %q", entry.MaxAmount)}
+	}
+	if max, ok := g.MaxBid[entry.Currency]; ok && amount.Cmp(max) > 0 {
+		return Decimal{}, &GuardError{fmt.Sprintf("amount %s %s exceeds the maximum bid of %s",
+			entry.MaxAmount, entry.Currency, max)}
+	}
+	added := amount
+	if prev, ok := exposure.Outstanding[entry.ItemID]; ok && prev.Currency == entry.Currency {
+		added = amount.Sub(prev.Amount)
+	}
+	if limit, ok := g.TotalLimit[entry.Currency]; ok {
+		total := exposure.exposure(entry.Currency, time.Time{}).Add(added)
+		if total.Cmp(limit) > 0 {
+			return Decimal{}, &GuardError{fmt.Sprintf("outstanding exposure of %s %s would exceed the limit of %s",
+				total, entry.Currency, limit)}
+		}
+	}
+	if limit, ok := g.DailyLimit[entry.Currency]; ok {
+		daily := exposure.exposure(entry.Currency, entry.Time.Add(-24*time.Hour)).Add(added)
+		if daily.Cmp(limit) > 0 {
+			return Decimal{}, &GuardError{fmt.Sprintf("daily exposure of %s %s would exceed the limit of %s",
+				daily, entry.Currency, limit)}
+		}
+	}
+	return amount, nil
+}
+
+// exposure returns the outstanding exposure in currency if since is zero,
+// and the exposure added since the given time otherwise.
+func (s *GuardState) exposure(currency string, since time.Time) Decimal {
+	var total Decimal
+	if since.IsZero() {
+		for _, b := range s.Outstanding {
+			if b.Currency == currency {
+				total = total.Add(b.Amount)
+			}
+		}
+		return total
+	}
+	for _, b := range s.History {
+		if b.Currency == currency && b.Time.After(since) {
+			total = total.Add(b.Amount)
+		}
+	}
+	return total
+}
+
+func (s *GuardState) record(t time.Time, itemID, currency string, amount Decimal) {
+	if s.Outstanding == nil {
+		s.Outstanding = map[string]GuardBid{}
+	}
+	added := amount
+	if prev, ok := s.Outstanding[itemID]; ok && prev.Currency == currency {
+		added = amount.Sub(prev.Amount)
+	}
+	s.Outstanding[itemID] = GuardBid{Time: t, Currency: currency, Amount: amount}
+	if added.Sign() > 0 {
+		s.History = append(s.History, GuardBid{Time: t, Currency: currency, Amount: added})
+	}
+	cutoff := t.Add(-24 * time.Hour)
+	for len(s.History) > 0 && !s.History[0].Time.After(cutoff) {
+		s.History = s.History[1:]
+	}
+}
+
+// Release removes the proxy bid on an item from the outstanding exposure,
+// typically once its auction ended.
+func (g *OfferGuard) Release(itemID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.simulated != nil {
+		delete(g.simulated.Outstanding, itemID)
+	}
+	if err := g.load(); err != nil {
+		return err
+	}
+	delete(g.live.Outstanding, itemID)
+	return g.save()
+}
+
+// Exposure returns the outstanding exposure in currency.
+// Bids simulated in dry-run mode are not included.
+func (g *OfferGuard) Exposure(currency string) (Decimal, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if err := g.load(); err != nil {
+		return Decimal{}, err
+	}
+	return g.live.exposure(currency, time.Time{}), nil
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
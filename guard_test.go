@@ -0,0 +1,152 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfferGuard(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprintf(w, `{"proxyBidId": "%d"}`, calls)
+	})
+
+	dec := func(s string) ebay.Decimal {
+		d, _ := ebay.ParseDecimal(s)
+		return d
+	}
+	clock := &fakeClock{now: time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)}
+	var entries []ebay.AuditEntry
+	guard := &ebay.OfferGuard{
+		Offer:               client.Buy.Offer,
+		MaxBid:              map[string]ebay.Decimal{"USD": dec("100")},
+		DailyLimit:          map[string]ebay.Decimal{"USD": dec("150")},
+		TotalLimit:          map[string]ebay.Decimal{"USD": dec("200")},
+		AllowedMarketplaces: []string{ebay.BuyMarketplaceUSA},
+		Clock:               clock,
+		Audit: ebay.AuditLogFunc(func(e ebay.AuditEntry) error {
+			entries = append(entries, e)
+			return nil
+		}),
+	}
+	var _ ebay.ProxyBidder = guard
+	ctx := context.Background()
+	bid := func(itemID, marketplaceID, amount string) error {
+		_, err := guard.PlaceProxyBid(ctx, itemID, marketplaceID, amount, "USD", false)
+		return err
+	}
+
+	assert.Nil(t, bid("1", ebay.BuyMarketplaceUSA, "80.00"))
+	assert.IsType(t, &ebay.GuardError{}, bid("2", ebay.BuyMarketplaceUSA, "100.01"))
+	assert.IsType(t, &ebay.GuardError{}, bid("2", ebay.BuyMarketplaceGermany, "10.00"))
+	assert.IsType(t, &ebay.GuardError{}, bid("2", ebay.BuyMarketplaceUSA, "abc"))
+	// Raising the bid on item 1 only adds 20 to the daily exposure.
+	assert.Nil(t, bid("1", ebay.BuyMarketplaceUSA, "100.00"))
+	assert.IsType(t, &ebay.GuardError{}, bid("2", ebay.BuyMarketplaceUSA, "60.00"))
+	exposure, err := guard.Exposure("USD")
+	assert.Nil(t, err)
+	assert.Equal(t, "100", exposure.String())
+
+	clock.now = clock.now.Add(25 * time.Hour)
+	assert.Nil(t, bid("2", ebay.BuyMarketplaceUSA, "100.00"))
+	// The total limit still applies.
+	err = bid("3", ebay.BuyMarketplaceUSA, "10.00")
+	assert.IsType(t, &ebay.GuardError{}, err)
+	assert.True(t, strings.Contains(err.Error(), "outstanding exposure"))
+	assert.Nil(t, guard.Release("1"))
+	assert.Nil(t, bid("3", ebay.BuyMarketplaceUSA, "10.00"))
+	assert.Equal(t, 4, calls)
+
+	guard.DryRun = true
+	assert.Nil(t, bid("4", ebay.BuyMarketplaceUSA, "5.00"))
+	assert.Equal(t, 4, calls)
+	exposure, err = guard.Exposure("USD")
+	assert.Nil(t, err)
+	assert.Equal(t, "110", exposure.String())
+	// Dry-run bids add up against the limits without changing the actual exposure.
+	assert.Nil(t, bid("5", ebay.BuyMarketplaceUSA, "30.00"))
+	err = bid("6", ebay.BuyMarketplaceUSA, "10.00")
+	assert.IsType(t, &ebay.GuardError{}, err)
+	assert.True(t, strings.Contains(err.Error(), "daily exposure"))
+	exposure, err = guard.Exposure("USD")
+	assert.Nil(t, err)
+	assert.Equal(t, "110", exposure.String())
+	assert.Equal(t, 4, calls)
+
+	assert.Equal(t, 12, len(entries))
+	last := entries[len(entries)-1]
+	assert.True(t, last.DryRun)
+	assert.True(t, strings.Contains(last.Request, `{"maxAmount":{"currency":"USD","value":"10.00"}}`))
+	assert.True(t, strings.Contains(last.Request, "X-Ebay-C-Marketplace-Id: EBAY_US"))
+	assert.Equal(t, "1", entries[0].ProxyBid.ProxyBidID)
+	assert.NotNil(t, entries[1].Err)
+}
+
+func TestOfferGuardUnknownCurrency(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("unexpected proxy bid")
+	})
+
+	max, _ := ebay.ParseDecimal("100")
+	guard := &ebay.OfferGuard{
+		Offer:  client.Buy.Offer,
+		MaxBid: map[string]ebay.Decimal{"USD": max},
+	}
+	_, err := guard.PlaceProxyBid(context.Background(), "1", ebay.BuyMarketplaceCanada, "10.00", "CAD", false)
+	assert.IsType(t, &ebay.GuardError{}, err)
+}
+
+func TestOfferGuardStore(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"proxyBidId": "1"}`)
+	})
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	limit, _ := ebay.ParseDecimal("100")
+	clock := &fakeClock{now: time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)}
+	newGuard := func() *ebay.OfferGuard {
+		return &ebay.OfferGuard{
+			Offer:      client.Buy.Offer,
+			DailyLimit: map[string]ebay.Decimal{"USD": limit},
+			Clock:      clock,
+			Store:      ebay.FileGuardStore{Path: filepath.Join(dir, "guard.json")},
+		}
+	}
+	ctx := context.Background()
+	_, err = newGuard().PlaceProxyBid(ctx, "1", ebay.BuyMarketplaceUSA, "80.00", "USD", false)
+	assert.Nil(t, err)
+
+	// A restarted program shares the daily limit.
+	guard := newGuard()
+	_, err = guard.PlaceProxyBid(ctx, "2", ebay.BuyMarketplaceUSA, "30.00", "USD", false)
+	assert.IsType(t, &ebay.GuardError{}, err)
+	assert.Equal(t, 1, calls)
+	exposure, err := guard.Exposure("USD")
+	assert.Nil(t, err)
+	assert.Equal(t, "80", exposure.String())
+}
@@ -0,0 +1,87 @@
+package ebay
+
+import (
+	"context"
+	"net/http"
+)
+
+// IdentityService handles communication with the Identity API.
+// The Identity API is served by the apiz host.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/identity/overview.html
+type IdentityService service
+
+// Valid values for the "accountType" user field.
+const (
+	IdentityAccountTypeIndividual = "INDIVIDUAL"
+	IdentityAccountTypeBusiness   = "BUSINESS"
+)
+
+// IdentityAddress represents the address of an eBay user.
+type IdentityAddress struct {
+	AddressLine1    string `json:"addressLine1"`
+	AddressLine2    string `json:"addressLine2"`
+	City            string `json:"city"`
+	StateOrProvince string `json:"stateOrProvince"`
+	PostalCode      string `json:"postalCode"`
+	Country         string `json:"country"`
+	County          string `json:"county"`
+}
+
+// IdentityPhone represents the phone number of an eBay user.
+type IdentityPhone struct {
+	CountryCode string `json:"countryCode"`
+	Number      string `json:"number"`
+	PhoneType   string `json:"phoneType"`
+}
+
+// IndividualAccount represents the details of an individual account.
+type IndividualAccount struct {
+	FirstName           string          `json:"firstName"`
+	LastName            string          `json:"lastName"`
+	Email               string          `json:"email"`
+	PrimaryPhone        IdentityPhone   `json:"primaryPhone"`
+	SecondaryPhone      IdentityPhone   `json:"secondaryPhone"`
+	RegistrationAddress IdentityAddress `json:"registrationAddress"`
+}
+
+// BusinessAccount represents the details of a business account.
+type BusinessAccount struct {
+	Name            string          `json:"name"`
+	DoingBusinessAs string          `json:"doingBusinessAs"`
+	Email           string          `json:"email"`
+	Website         string          `json:"website"`
+	Address         IdentityAddress `json:"address"`
+	PrimaryPhone    IdentityPhone   `json:"primaryPhone"`
+	SecondaryPhone  IdentityPhone   `json:"secondaryPhone"`
+	PrimaryContact  struct {
+		FirstName string `json:"firstName"`
+		LastName  string `json:"lastName"`
+	} `json:"primaryContact"`
+}
+
+// User represents an eBay user.
+// Depending on AccountType, either IndividualAccount or BusinessAccount is set.
+type User struct {
+	UserID                    string             `json:"userId"`
+	Username                  string             `json:"username"`
+	AccountType               string             `json:"accountType"`
+	RegistrationMarketplaceID string             `json:"registrationMarketplaceId"`
+	Status                    string             `json:"status"`
+	IndividualAccount         *IndividualAccount `json:"individualAccount"`
+	BusinessAccount           *BusinessAccount   `json:"businessAccount"`
+}
+
+// GetUser retrieves the eBay user the access token belongs to.
+// The personal details are only returned if the token has the relevant scopes.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/identity/resources/user/methods/getUser
+func (s *IdentityService) GetUser(ctx context.Context, opts ...Opt) (User, error) {
+	u := s.client.apizURL.String() + "commerce/identity/v1/user/"
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return User{}, err
+	}
+	var user User
+	return user, s.client.Do(ctx, req, &user)
+}
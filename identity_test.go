@@ -0,0 +1,58 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUser(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/identity/v1/user/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"userId": "id", "username": "user", "accountType": "BUSINESS",
+			"businessAccount": {"name": "Shop", "address": {"country": "US"}, "primaryContact": {"firstName": "A"}}}`)
+	})
+
+	user, err := client.Commerce.Identity.GetUser(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "user", user.Username)
+	assert.Equal(t, ebay.IdentityAccountTypeBusiness, user.AccountType)
+	assert.Nil(t, user.IndividualAccount)
+	assert.Equal(t, "Shop", user.BusinessAccount.Name)
+	assert.Equal(t, "US", user.BusinessAccount.Address.Country)
+	assert.Equal(t, "A", user.BusinessAccount.PrimaryContact.FirstName)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestGetUserAPIZ(t *testing.T) {
+	var urls []string
+	httpclient := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		urls = append(urls, r.URL.String())
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+	})}
+
+	_, err := ebay.NewClient(httpclient).Commerce.Identity.GetUser(context.Background())
+	assert.Nil(t, err)
+	_, err = ebay.NewSandboxClient(httpclient).Commerce.Identity.GetUser(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"https://apiz.ebay.com/commerce/identity/v1/user/",
+		"https://apiz.sandbox.ebay.com/commerce/identity/v1/user/",
+	}, urls)
+}
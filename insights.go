@@ -0,0 +1,92 @@
+package ebay
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// MarketplaceInsightsService handles communication with the Marketplace Insights API.
+// The Marketplace Insights API is restricted to approved applications.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/marketplace-insights/static/overview.html
+type MarketplaceInsightsService service
+
+// ItemSales represents the sales history of an item.
+type ItemSales struct {
+	ItemID string `json:"itemId"`
+	Title  string `json:"title"`
+	Image  struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"image"`
+	AdditionalImages []struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"additionalImages"`
+	ThumbnailImages []struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"thumbnailImages"`
+	ItemHref      string `json:"itemHref"`
+	ItemGroupHref string `json:"itemGroupHref"`
+	ItemGroupType string `json:"itemGroupType"`
+	Seller        struct {
+		Username           string `json:"username"`
+		FeedbackPercentage string `json:"feedbackPercentage"`
+		FeedbackScore      int    `json:"feedbackScore"`
+	} `json:"seller"`
+	Condition     string   `json:"condition"`
+	ConditionID   string   `json:"conditionId"`
+	BuyingOptions []string `json:"buyingOptions"`
+	Epid          string   `json:"epid"`
+	ItemLocation  struct {
+		PostalCode string `json:"postalCode"`
+		Country    string `json:"country"`
+	} `json:"itemLocation"`
+	Categories []struct {
+		CategoryID string `json:"categoryId"`
+	} `json:"categories"`
+	AdultOnly         bool      `json:"adultOnly"`
+	LastSoldDate      time.Time `json:"lastSoldDate"`
+	LastSoldPrice     Amount    `json:"lastSoldPrice"`
+	TotalSoldQuantity int       `json:"totalSoldQuantity"`
+}
+
+// SalesHistory represents a page of item sales search results.
+type SalesHistory struct {
+	Href       string      `json:"href"`
+	Total      int         `json:"total"`
+	Next       string      `json:"next"`
+	Prev       string      `json:"prev"`
+	Limit      int         `json:"limit"`
+	Offset     int         `json:"offset"`
+	ItemSales  []ItemSales `json:"itemSales"`
+	Refinement Refinement  `json:"refinement"`
+	Warnings   []Error     `json:"warnings"`
+}
+
+// SearchItemSales searches for items sold in the last 90 days.
+// It accepts the same OptBrowseSearch options as BrowseService.Search.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/marketplace-insights/resources/item_sales/methods/search
+func (s *MarketplaceInsightsService) SearchItemSales(ctx context.Context, marketplaceID string, opts ...Opt) (SalesHistory, error) {
+	u := "buy/marketplace_insights/v1_beta/item_sales/search"
+	opts = append(opts[:len(opts):len(opts)], OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return SalesHistory{}, err
+	}
+	var history SalesHistory
+	return history, s.client.Do(ctx, req, &history)
+}
+
+// SearchItemSalesPages searches for items sold in the last 90 days and calls fn for each page
+// of results until there are no more results, BrowseSearchMaxResults is reached or fn returns an error.
+// See BrowseService.SearchPages.
+func (s *MarketplaceInsightsService) SearchItemSalesPages(ctx context.Context, marketplaceID string, fn func(SalesHistory) error, opts ...Opt) error {
+	return searchPages(opts, func(opts []Opt) (searchPage, error) {
+		history, err := s.SearchItemSales(ctx, marketplaceID, opts...)
+		if err != nil {
+			return searchPage{}, err
+		}
+		return searchPage{history.Offset, history.Limit, history.Total, len(history.ItemSales), history.Next}, fn(history)
+	})
+}
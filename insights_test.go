@@ -0,0 +1,47 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchItemSalesPages(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/marketplace_insights/v1_beta/item_sales/search", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		assert.Equal(t, "iphone", r.URL.Query().Get("q"))
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"total": 2, "limit": 1, "offset": 0, "next": "next",
+				"itemSales": [{"itemId": "1", "lastSoldDate": "2019-01-03T05:00:00.000Z",
+				"lastSoldPrice": {"value": "100.00", "currency": "USD"}, "totalSoldQuantity": 3}]}`)
+		case "1":
+			fmt.Fprint(w, `{"total": 2, "limit": 1, "offset": 1, "itemSales": [{"itemId": "2"}]}`)
+		default:
+			t.Fatalf("unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	})
+
+	var sales []ebay.ItemSales
+	err := client.Buy.MarketplaceInsights.SearchItemSalesPages(context.Background(), ebay.BuyMarketplaceUSA, func(h ebay.SalesHistory) error {
+		sales = append(sales, h.ItemSales...)
+		return nil
+	}, ebay.OptBrowseSearch("iphone"))
+	assert.Nil(t, err)
+	assert.Len(t, sales, 2)
+	assert.Equal(t, ebay.Amount{Value: "100.00", Currency: "USD"}, sales[0].LastSoldPrice)
+	assert.Equal(t, time.Date(2019, 1, 3, 5, 0, 0, 0, time.UTC), sales[0].LastSoldDate)
+	assert.Equal(t, 3, sales[0].TotalSoldQuantity)
+	assert.Equal(t, "2", sales[1].ItemID)
+}
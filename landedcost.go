@@ -0,0 +1,120 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LandedCost represents the cost of a quantity of an item delivered with one of its shipping options.
+// All the amounts are in Currency. Each tax is rounded to the minor unit of Currency,
+// the other amounts are exact.
+type LandedCost struct {
+	ShippingServiceCode      string
+	ShippingCostType         string
+	MinEstimatedDeliveryDate time.Time
+	MaxEstimatedDeliveryDate time.Time
+	Quantity                 int
+	Currency                 string
+	ItemCost                 Decimal // Price times quantity.
+	ShippingCost             Decimal // Shipping cost for the whole quantity.
+	Taxes                    Decimal // Taxes not already included in the price.
+	ImportCharges            Decimal
+	Total                    Decimal
+}
+
+// LandedCosts computes the landed cost of quantity units of item for each of its shipping options.
+//
+// Shipping options and taxes depend on the contextual location used to retrieve the item.
+// Use GetItemLandedCosts to retrieve the item for a specific destination.
+func LandedCosts(item Item, quantity int) ([]LandedCost, error) {
+	if quantity < 1 {
+		return nil, fmt.Errorf("invalid quantity %d", quantity)
+	}
+	currency := item.Price.Currency
+	price, err := parseAmount(item.Price, currency)
+	if err != nil {
+		return nil, err
+	}
+	itemCost := price.Mul(NewDecimal(int64(quantity)))
+	var costs []LandedCost
+	for _, opt := range item.ShippingOptions {
+		shipping, err := parseAmount(opt.ShippingCost, currency)
+		if err != nil {
+			return nil, err
+		}
+		additional, err := parseAmount(opt.AdditionalShippingCostPerUnit, currency)
+		if err != nil {
+			return nil, err
+		}
+		estimated := opt.QuantityUsedForEstimate
+		if estimated < 1 {
+			estimated = 1
+		}
+		if quantity > estimated {
+			shipping = shipping.Add(additional.Mul(NewDecimal(int64(quantity - estimated))))
+		}
+		importCharges, err := parseAmount(opt.ImportCharges, currency)
+		if err != nil {
+			return nil, err
+		}
+		taxes, err := landedTaxes(item, currency, itemCost, shipping)
+		if err != nil {
+			return nil, err
+		}
+		costs = append(costs, LandedCost{
+			ShippingServiceCode:      opt.ShippingServiceCode,
+			ShippingCostType:         opt.ShippingCostType,
+			MinEstimatedDeliveryDate: opt.MinEstimatedDeliveryDate,
+			MaxEstimatedDeliveryDate: opt.MaxEstimatedDeliveryDate,
+			Quantity:                 quantity,
+			Currency:                 currency,
+			ItemCost:                 itemCost,
+			ShippingCost:             shipping,
+			Taxes:                    taxes,
+			ImportCharges:            importCharges,
+			Total:                    itemCost.Add(shipping).Add(taxes).Add(importCharges),
+		})
+	}
+	return costs, nil
+}
+
+// landedTaxes returns the sum of the taxes not included in the price, each rounded to the minor unit of currency.
+func landedTaxes(item Item, currency string, itemCost, shipping Decimal) (Decimal, error) {
+	hundredth, _ := ParseDecimal("0.01")
+	var taxes Decimal
+	for _, tax := range item.Taxes {
+		if tax.IncludedInPrice || tax.TaxPercentage == "" {
+			continue
+		}
+		pct, err := ParseDecimal(tax.TaxPercentage)
+		if err != nil {
+			return Decimal{}, err
+		}
+		base := itemCost
+		if tax.ShippingAndHandlingTaxed {
+			base = base.Add(shipping)
+		}
+		taxes = taxes.Add(base.Mul(pct).Mul(hundredth).Round(CurrencyMinorUnit(currency)))
+	}
+	return taxes, nil
+}
+
+// parseAmount parses a and ensures it is expressed in currency.
+func parseAmount(a Amount, currency string) (Decimal, error) {
+	if a.Value != "" && a.Currency != currency {
+		return Decimal{}, fmt.Errorf("amount %s %s does not match currency %s", a.Value, a.Currency, currency)
+	}
+	return a.Decimal()
+}
+
+// GetItemLandedCosts retrieves an item as seen from the given country and zip
+// and computes the landed cost of quantity units for each of its shipping options.
+func (s *BrowseService) GetItemLandedCosts(ctx context.Context, itemID string, quantity int, country, zip string, opts ...Opt) ([]LandedCost, error) {
+	opts = append(opts, OptBrowseContextualLocation(country, zip))
+	it, err := s.GetItem(ctx, itemID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return LandedCosts(it, quantity)
+}
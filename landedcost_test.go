@@ -0,0 +1,74 @@
+package ebay_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetItemLandedCosts(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "contextualLocation=country%3DUS%2Czip%3D94105", r.Header.Get("X-EBAY-C-ENDUSERCTX"))
+		fmt.Fprint(w, `{
+			"price": {"value": "10.99", "currency": "USD"},
+			"shippingOptions": [{
+				"shippingServiceCode": "USPS",
+				"shippingCost": {"value": "4.00", "currency": "USD"},
+				"additionalShippingCostPerUnit": {"value": "1.50", "currency": "USD"},
+				"quantityUsedForEstimate": 1,
+				"importCharges": {"value": "2.25", "currency": "USD"}
+			}],
+			"taxes": [
+				{"taxPercentage": "8.5", "shippingAndHandlingTaxed": true},
+				{"taxPercentage": "20", "includedInPrice": true}
+			]
+		}`)
+	})
+
+	costs, err := client.Buy.Browse.GetItemLandedCosts(context.Background(), "v1|202117468662|0", 3, "US", "94105")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(costs))
+	c := costs[0]
+	assert.Equal(t, "USPS", c.ShippingServiceCode)
+	assert.Equal(t, "USD", c.Currency)
+	assert.Equal(t, "32.97", c.ItemCost.String())
+	assert.Equal(t, "7", c.ShippingCost.String())
+	assert.Equal(t, "3.40", c.Taxes.StringFixed(2))
+	assert.Equal(t, "2.25", c.ImportCharges.String())
+	assert.Equal(t, "45.62", c.Total.StringFixed(2))
+}
+
+func TestLandedCostsCurrencyMismatch(t *testing.T) {
+	var it ebay.Item
+	err := json.Unmarshal([]byte(`{
+		"price": {"value": "10.99", "currency": "USD"},
+		"shippingOptions": [{"shippingCost": {"value": "4.00", "currency": "EUR"}}]
+	}`), &it)
+	assert.Nil(t, err)
+	_, err = ebay.LandedCosts(it, 1)
+	assert.NotNil(t, err)
+	_, err = ebay.LandedCosts(it, 0)
+	assert.NotNil(t, err)
+}
+
+func TestLandedCostsMinorUnit(t *testing.T) {
+	var it ebay.Item
+	err := json.Unmarshal([]byte(`{
+		"price": {"value": "1001", "currency": "JPY"},
+		"shippingOptions": [{"shippingCost": {"value": "0", "currency": "JPY"}}],
+		"taxes": [{"taxPercentage": "8.5"}]
+	}`), &it)
+	assert.Nil(t, err)
+	costs, err := ebay.LandedCosts(it, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "85", costs[0].Taxes.String())
+	assert.Equal(t, "1086", costs[0].Total.String())
+}
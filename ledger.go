@@ -0,0 +1,244 @@
+package ebay
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LedgerEntry represents a proxy bid placed, or attempted, by the buyer.
+// ProxyBidID is empty and Error is set if eBay rejected the proxy bid.
+// AuctionEndDate is zero if unknown.
+type LedgerEntry struct {
+	Time           time.Time `json:"time"`
+	ItemID         string    `json:"itemId"`
+	MarketplaceID  string    `json:"marketplaceId"`
+	MaxAmount      Amount    `json:"maxAmount"`
+	ProxyBidID     string    `json:"proxyBidId,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	AuctionEndDate time.Time `json:"auctionEndDate"`
+}
+
+// LedgerAuction represents the state of an auction as last reported by GetBidding.
+type LedgerAuction struct {
+	ItemID          string    `json:"itemId"`
+	MarketplaceID   string    `json:"marketplaceId"`
+	AuctionStatus   string    `json:"auctionStatus"`
+	AuctionEndDate  time.Time `json:"auctionEndDate"`
+	HighBidder      bool      `json:"highBidder"`
+	CurrentPrice    Amount    `json:"currentPrice"`
+	CurrentProxyBid Amount    `json:"currentProxyBid"`
+	ReconciledAt    time.Time `json:"reconciledAt"`
+}
+
+// LedgerState represents the content of a Ledger.
+type LedgerState struct {
+	Entries  []LedgerEntry            `json:"entries"`
+	Auctions map[string]LedgerAuction `json:"auctions"`
+}
+
+// LedgerStore persists the content of a Ledger.
+type LedgerStore interface {
+	// Load returns the saved state, or an empty state if nothing was saved.
+	Load() (LedgerState, error)
+	Save(LedgerState) error
+}
+
+// FileLedgerStore is a LedgerStore saving the ledger as a JSON file at Path.
+type FileLedgerStore struct {
+	Path string
+}
+
+// Load allows FileLedgerStore to implement LedgerStore.
+func (s FileLedgerStore) Load() (LedgerState, error) {
+	var state LedgerState
+	b, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return state, errors.WithStack(err)
+	}
+	return state, errors.WithStack(json.Unmarshal(b, &state))
+}
+
+// Save allows FileLedgerStore to implement LedgerStore.
+// The file is replaced atomically.
+func (s FileLedgerStore) Save(state LedgerState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeFileAtomic(s.Path, b)
+}
+
+// Ledger records the proxy bids placed by the buyer and reconciles them
+// with the bidding details returned by eBay.
+type Ledger struct {
+	Store LedgerStore
+
+	// Clock defaults to the system clock.
+	Clock Clock
+
+	mu sync.Mutex
+}
+
+func (l *Ledger) update(fn func(*LedgerState)) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	state, err := l.Store.Load()
+	if err != nil {
+		return err
+	}
+	fn(&state)
+	return l.Store.Save(state)
+}
+
+// Record adds an entry to the ledger.
+func (l *Ledger) Record(entry LedgerEntry) error {
+	return l.update(func(state *LedgerState) {
+		state.Entries = append(state.Entries, entry)
+	})
+}
+
+// Reconcile records the bidding details of an auction as returned by GetBidding.
+func (l *Ledger) Reconcile(marketplaceID string, b Bidding) error {
+	now := clockOrSystem(l.Clock).Now()
+	return l.update(func(state *LedgerState) {
+		if state.Auctions == nil {
+			state.Auctions = map[string]LedgerAuction{}
+		}
+		state.Auctions[b.ItemID] = LedgerAuction{
+			ItemID:          b.ItemID,
+			MarketplaceID:   marketplaceID,
+			AuctionStatus:   b.AuctionStatus,
+			AuctionEndDate:  b.AuctionEndDate,
+			HighBidder:      b.HighBidder,
+			CurrentPrice:    b.CurrentPrice,
+			CurrentProxyBid: b.CurrentProxyBid.MaxAmount,
+			ReconciledAt:    now,
+		}
+	})
+}
+
+// ReconcileBidding retrieves the bidding details of an auction and records them.
+func (l *Ledger) ReconcileBidding(ctx context.Context, offer *OfferService, itemID, marketplaceID string) error {
+	b, err := offer.GetBidding(ctx, itemID, marketplaceID)
+	if err != nil {
+		return err
+	}
+	if b.ItemID == "" {
+		b.ItemID = itemID
+	}
+	return l.Reconcile(marketplaceID, b)
+}
+
+// History returns the entries recorded for an item, oldest first.
+func (l *Ledger) History(itemID string) ([]LedgerEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	state, err := l.Store.Load()
+	if err != nil {
+		return nil, err
+	}
+	var entries []LedgerEntry
+	for _, e := range state.Entries {
+		if e.ItemID == itemID {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// Exposure returns the maximum outstanding liability per currency across live auctions:
+// the sum of the maximum amounts of the buyer's current proxy bids.
+//
+// The current proxy bid of an auction is the one reported by its last reconciliation, unless
+// a proxy bid was placed successfully since. Auctions reconciled as ended or past their
+// end date are not live. The end date of an auction never reconciled is the one of its latest entry:
+// if unknown, the proxy bid counts toward the exposure until the auction is reconciled.
+func (l *Ledger) Exposure() (map[string]Decimal, error) {
+	now := clockOrSystem(l.Clock).Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	state, err := l.Store.Load()
+	if err != nil {
+		return nil, err
+	}
+	current := map[string]Amount{}
+	ends := map[string]time.Time{}
+	for id, a := range state.Auctions {
+		current[id] = a.CurrentProxyBid
+		ends[id] = a.AuctionEndDate
+	}
+	for _, e := range state.Entries {
+		if e.ProxyBidID == "" {
+			continue
+		}
+		if a, ok := state.Auctions[e.ItemID]; ok && !e.Time.After(a.ReconciledAt) {
+			continue
+		}
+		current[e.ItemID] = e.MaxAmount
+		if !e.AuctionEndDate.IsZero() {
+			ends[e.ItemID] = e.AuctionEndDate
+		}
+	}
+	exposure := map[string]Decimal{}
+	for id, amount := range current {
+		if a, ok := state.Auctions[id]; ok && a.AuctionStatus == BiddingAuctionStatusEnded {
+			continue
+		}
+		if end := ends[id]; !end.IsZero() && !now.Before(end) {
+			continue
+		}
+		if amount.Value == "" {
+			continue
+		}
+		d, err := amount.Decimal()
+		if err != nil {
+			return nil, err
+		}
+		exposure[amount.Currency] = exposure[amount.Currency].Add(d)
+	}
+	return exposure, nil
+}
+
+// LedgerBidder wraps a ProxyBidder to record every proxy bid in a Ledger.
+type LedgerBidder struct {
+	Bidder ProxyBidder
+	Ledger *Ledger
+
+	// Offer, if set, is used to retrieve the auction end date of successful proxy bids,
+	// so that they stop counting toward the exposure once the auction ends.
+	Offer *OfferService
+}
+
+// PlaceProxyBid places the proxy bid and records it along with its result.
+// The auction end date is left unknown if it cannot be retrieved.
+// See OfferService.PlaceProxyBid.
+func (b *LedgerBidder) PlaceProxyBid(ctx context.Context, itemID, marketplaceID, maxAmount, currency string, userConsentAdultOnlyItem bool, opts ...Opt) (ProxyBid, error) {
+	bid, err := b.Bidder.PlaceProxyBid(ctx, itemID, marketplaceID, maxAmount, currency, userConsentAdultOnlyItem, opts...)
+	entry := LedgerEntry{
+		Time:          clockOrSystem(b.Ledger.Clock).Now(),
+		ItemID:        itemID,
+		MarketplaceID: marketplaceID,
+		MaxAmount:     Amount{Value: maxAmount, Currency: currency},
+		ProxyBidID:    bid.ProxyBidID,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	} else if b.Offer != nil {
+		if bidding, biddingErr := b.Offer.GetBidding(ctx, itemID, marketplaceID); biddingErr == nil {
+			entry.AuctionEndDate = bidding.AuctionEndDate
+		}
+	}
+	if recordErr := b.Ledger.Record(entry); recordErr != nil && err == nil {
+		return bid, recordErr
+	}
+	return bid, err
+}
@@ -0,0 +1,119 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedger(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	end := now.Add(time.Hour).Format(time.RFC3339)
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/a/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"proxyBidId": "a1"}`)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/b/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"errors": [{"errorId": %d}]}`, ebay.ErrPlaceProxyBidAmountTooLow)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/c/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"proxyBidId": "c1"}`)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/a", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"auctionEndDate": "%s", "currentProxyBid": {"maxAmount": {"value": "15.00", "currency": "USD"}}}`, end)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/c", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"itemId": "c", "auctionStatus": "ENDED", "currentProxyBid": {"maxAmount": {"value": "30.00", "currency": "USD"}}}`)
+	})
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	clock := &fakeClock{now: now}
+	ledger := &ebay.Ledger{Store: ebay.FileLedgerStore{Path: filepath.Join(dir, "ledger.json")}, Clock: clock}
+	bidder := &ebay.LedgerBidder{Bidder: client.Buy.Offer, Ledger: ledger}
+	ctx := context.Background()
+
+	_, err = bidder.PlaceProxyBid(ctx, "a", ebay.BuyMarketplaceUSA, "10.00", "USD", false)
+	assert.Nil(t, err)
+	_, err = bidder.PlaceProxyBid(ctx, "b", ebay.BuyMarketplaceGermany, "20.00", "EUR", false)
+	assert.NotNil(t, err)
+	_, err = bidder.PlaceProxyBid(ctx, "c", ebay.BuyMarketplaceUSA, "30.00", "USD", false)
+	assert.Nil(t, err)
+
+	exposure, err := ledger.Exposure()
+	assert.Nil(t, err)
+	assert.Equal(t, "40", exposure["USD"].String())
+	_, ok := exposure["EUR"]
+	assert.False(t, ok)
+
+	clock.now = clock.now.Add(time.Minute)
+	assert.Nil(t, ledger.ReconcileBidding(ctx, client.Buy.Offer, "a", ebay.BuyMarketplaceUSA))
+	assert.Nil(t, ledger.ReconcileBidding(ctx, client.Buy.Offer, "c", ebay.BuyMarketplaceUSA))
+	exposure, err = ledger.Exposure()
+	assert.Nil(t, err)
+	assert.Equal(t, "15", exposure["USD"].String())
+
+	clock.now = clock.now.Add(time.Minute)
+	_, err = bidder.PlaceProxyBid(ctx, "a", ebay.BuyMarketplaceUSA, "25.00", "USD", false)
+	assert.Nil(t, err)
+	exposure, err = ledger.Exposure()
+	assert.Nil(t, err)
+	assert.Equal(t, "25", exposure["USD"].String())
+
+	history, err := ledger.History("a")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(history))
+	assert.Equal(t, "a1", history[0].ProxyBidID)
+	assert.Equal(t, "25.00", history[1].MaxAmount.Value)
+
+	// The auction ends.
+	clock.now = clock.now.Add(time.Hour)
+	exposure, err = ledger.Exposure()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, exposure["USD"].Sign())
+}
+
+func TestLedgerUnreconciledAuctionEnds(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/a/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"proxyBidId": "a1"}`)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/a", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"auctionEndDate": "%s"}`, now.Add(time.Hour).Format(time.RFC3339))
+	})
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	clock := &fakeClock{now: now}
+	ledger := &ebay.Ledger{Store: ebay.FileLedgerStore{Path: filepath.Join(dir, "ledger.json")}, Clock: clock}
+	bidder := &ebay.LedgerBidder{Bidder: client.Buy.Offer, Ledger: ledger, Offer: client.Buy.Offer}
+	_, err = bidder.PlaceProxyBid(context.Background(), "a", ebay.BuyMarketplaceUSA, "10.00", "USD", false)
+	assert.Nil(t, err)
+	exposure, err := ledger.Exposure()
+	assert.Nil(t, err)
+	assert.Equal(t, "10", exposure["USD"].String())
+
+	// The auction ends without ever being reconciled.
+	clock.now = clock.now.Add(time.Hour)
+	exposure, err = ledger.Exposure()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, exposure["USD"].Sign())
+}
@@ -0,0 +1,101 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MarketingService handles communication with the Buy Marketing API.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/marketing/static/overview.html
+type MarketingService service
+
+// Valid values for the "metric_name" merchandised product query parameter.
+const (
+	MarketingMetricBestSelling = "BEST_SELLING"
+)
+
+// MarketingProduct represents a product returned by the Buy Marketing API.
+type MarketingProduct struct {
+	Epid  string `json:"epid"`
+	Title string `json:"title"`
+	Image struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"image"`
+	MarketPriceDetails []struct {
+		ConditionGroup      string   `json:"conditionGroup"`
+		ConditionIDs        []string `json:"conditionIds"`
+		EstimatedStartPrice Amount   `json:"estimatedStartPrice"`
+	} `json:"marketPriceDetails"`
+	AverageRating string `json:"averageRating"`
+	RatingAspects []struct {
+		Name               string `json:"name"`
+		Description        string `json:"description"`
+		Count              int    `json:"count"`
+		RatingAspectValues []struct {
+			Value      string `json:"value"`
+			Count      int    `json:"count"`
+			Percentage string `json:"percentage"`
+		} `json:"ratingAspectValues"`
+	} `json:"ratingAspects"`
+	RatingCount int `json:"ratingCount"`
+	ReviewCount int `json:"reviewCount"`
+}
+
+// OptBrowseSearchEPID returns the option to use with BrowseService.Search
+// to search for the items listed for the merchandised product.
+func (p MarketingProduct) OptBrowseSearchEPID() Opt {
+	return OptBrowseSearchEPIDString(p.Epid)
+}
+
+// GetMerchandisedProducts retrieves the products of a category that match metricName,
+// for example the best selling products.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/marketing/resources/merchandised_product/methods/getMerchandisedProducts
+func (s *MarketingService) GetMerchandisedProducts(ctx context.Context, marketplaceID, metricName, categoryID string, limit int, opts ...Opt) ([]MarketingProduct, error) {
+	params := url.Values{}
+	params.Set("metric_name", metricName)
+	params.Set("category_id", categoryID)
+	if limit > 0 {
+		params.Set("limit", fmt.Sprint(limit))
+	}
+	u := "buy/marketing/v1_beta/merchandised_product?" + params.Encode()
+	opts = append(opts, OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		MerchandisedProducts []MarketingProduct `json:"merchandisedProducts"`
+	}
+	return resp.MerchandisedProducts, s.client.Do(ctx, req, &resp)
+}
+
+// GetAlsoBoughtProducts retrieves the products also bought by buyers of the product.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/marketing/resources/product/methods/getAlsoBoughtByProduct
+func (s *MarketingService) GetAlsoBoughtProducts(ctx context.Context, marketplaceID, epid string, opts ...Opt) ([]MarketingProduct, error) {
+	return s.getRelatedProducts(ctx, "get_also_bought_products", marketplaceID, epid, opts)
+}
+
+// GetAlsoViewedProducts retrieves the products also viewed by buyers who viewed the product.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/marketing/resources/product/methods/getAlsoViewedByProduct
+func (s *MarketingService) GetAlsoViewedProducts(ctx context.Context, marketplaceID, epid string, opts ...Opt) ([]MarketingProduct, error) {
+	return s.getRelatedProducts(ctx, "get_also_viewed_products", marketplaceID, epid, opts)
+}
+
+func (s *MarketingService) getRelatedProducts(ctx context.Context, method, marketplaceID, epid string, opts []Opt) ([]MarketingProduct, error) {
+	u := fmt.Sprintf("buy/marketing/v1_beta/product/%s?epid=%s", method, url.QueryEscape(epid))
+	opts = append(opts, OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Products []MarketingProduct `json:"products"`
+	}
+	return resp.Products, s.client.Do(ctx, req, &resp)
+}
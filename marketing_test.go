@@ -0,0 +1,60 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMerchandisedProducts(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/marketing/v1_beta/merchandised_product", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		assert.Equal(t, "BEST_SELLING", r.URL.Query().Get("metric_name"))
+		assert.Equal(t, "9355", r.URL.Query().Get("category_id"))
+		assert.Equal(t, "3", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{"merchandisedProducts": [{"epid": "240420050", "title": "Phone",
+			"marketPriceDetails": [{"conditionGroup": "NEW", "estimatedStartPrice": {"value": "100.00", "currency": "USD"}}]}]}`)
+	})
+
+	products, err := client.Buy.Marketing.GetMerchandisedProducts(context.Background(), ebay.BuyMarketplaceUSA, ebay.MarketingMetricBestSelling, "9355", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, "240420050", products[0].Epid)
+	assert.Equal(t, ebay.Amount{Value: "100.00", Currency: "USD"}, products[0].MarketPriceDetails[0].EstimatedStartPrice)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.ebay.com/buy/browse/v1/item_summary/search", nil)
+	products[0].OptBrowseSearchEPID()(req)
+	assert.Equal(t, "epid=240420050", req.URL.RawQuery)
+}
+
+func TestGetAlsoBoughtAndViewedProducts(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	for _, method := range []string{"get_also_bought_products", "get_also_viewed_products"} {
+		method := method
+		mux.HandleFunc("/buy/marketing/v1_beta/product/"+method, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" {
+				t.Fatalf("expected GET method, got: %s", r.Method)
+			}
+			assert.Equal(t, "240420050", r.URL.Query().Get("epid"))
+			fmt.Fprintf(w, `{"products": [{"epid": "%s"}]}`, method)
+		})
+	}
+
+	bought, err := client.Buy.Marketing.GetAlsoBoughtProducts(context.Background(), ebay.BuyMarketplaceUSA, "240420050")
+	assert.Nil(t, err)
+	assert.Equal(t, "get_also_bought_products", bought[0].Epid)
+	viewed, err := client.Buy.Marketing.GetAlsoViewedProducts(context.Background(), ebay.BuyMarketplaceUSA, "240420050")
+	assert.Nil(t, err)
+	assert.Equal(t, "get_also_viewed_products", viewed[0].Epid)
+}
@@ -0,0 +1,238 @@
+package ebay
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SearchEventType describes the kind of change detected by a SearchMonitor.
+type SearchEventType string
+
+// Valid values for SearchEventType.
+const (
+	SearchEventNew             SearchEventType = "NEW"
+	SearchEventEnded           SearchEventType = "ENDED"
+	SearchEventPriceChanged    SearchEventType = "PRICE_CHANGED"
+	SearchEventBidCountChanged SearchEventType = "BID_COUNT_CHANGED"
+)
+
+// SearchEvent represents a change in the results of a saved search.
+// Previous is nil for new items and Current is nil for ended items.
+type SearchEvent struct {
+	Type     SearchEventType
+	ItemID   string
+	Previous *SearchSnapshotItem
+	Current  *SearchSnapshotItem
+}
+
+// SearchSnapshotItem represents the state of an item the last time a saved search was run.
+type SearchSnapshotItem struct {
+	ItemID          string    `json:"itemId"`
+	Title           string    `json:"title"`
+	Price           Amount    `json:"price"`
+	CurrentBidPrice Amount    `json:"currentBidPrice"`
+	BidCount        int       `json:"bidCount"`
+	ItemEndDate     time.Time `json:"itemEndDate"`
+}
+
+// SearchStore persists the last seen results of saved searches.
+type SearchStore interface {
+	// Load returns the last saved results of a search, or nil if the search was never saved.
+	Load(name string) (map[string]SearchSnapshotItem, error)
+	// Save replaces the saved results of a search.
+	Save(name string, items map[string]SearchSnapshotItem) error
+}
+
+// FileSearchStore is a SearchStore saving each search as a JSON file in Dir.
+type FileSearchStore struct {
+	Dir string
+}
+
+func (s FileSearchStore) path(name string) string {
+	return filepath.Join(s.Dir, url.PathEscape(name)+".json")
+}
+
+// Load allows FileSearchStore to implement SearchStore.
+func (s FileSearchStore) Load(name string) (map[string]SearchSnapshotItem, error) {
+	b, err := ioutil.ReadFile(s.path(name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var items map[string]SearchSnapshotItem
+	return items, errors.WithStack(json.Unmarshal(b, &items))
+}
+
+// Save allows FileSearchStore to implement SearchStore.
+// The file is replaced atomically.
+func (s FileSearchStore) Save(name string, items map[string]SearchSnapshotItem) error {
+	b, err := json.Marshal(items)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeFileAtomic(s.path(name), b)
+}
+
+// writeFileAtomic replaces the content of a file by writing to a temporary file first.
+func writeFileAtomic(path string, b []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(f.Name(), path))
+}
+
+// SearchMonitor periodically runs a saved search and reports the changes in its results.
+type SearchMonitor struct {
+	Browse *BrowseService
+	Store  SearchStore
+
+	// Name identifies the saved search in Store.
+	Name string
+
+	// Opts describes the search.
+	Opts []Opt
+
+	// Interval is the duration between two runs of the search. Defaults to 15 minutes.
+	Interval time.Duration
+
+	// ErrorHandler, if set, is called with the errors encountered by Run
+	// instead of stopping the monitoring.
+	ErrorHandler func(error)
+}
+
+// Check runs the search once, saves its results and returns the changes since the last run.
+// Every item is reported as new the first time a search is checked.
+// Items no longer returned by the search are reported as ended.
+//
+// When the search returns more than BrowseSearchMaxResults results, only part of them can be
+// retrieved: items no longer returned are then kept in the saved results and not reported as ended.
+func (m *SearchMonitor) Check(ctx context.Context) ([]SearchEvent, error) {
+	previous, err := m.Store.Load(m.Name)
+	if err != nil {
+		return nil, err
+	}
+	current := map[string]SearchSnapshotItem{}
+	total := 0
+	err = m.Browse.SearchPages(ctx, func(search Search) error {
+		total = search.Total
+		for _, it := range search.ItemSummaries {
+			current[it.ItemID] = SearchSnapshotItem{
+				ItemID:          it.ItemID,
+				Title:           it.Title,
+				Price:           it.Price,
+				CurrentBidPrice: it.CurrentBidPrice,
+				BidCount:        it.BidCount,
+				ItemEndDate:     it.ItemEndDate,
+			}
+		}
+		return nil
+	}, m.Opts...)
+	if err != nil {
+		return nil, err
+	}
+	truncated := total > len(current)
+	events := diffSearch(previous, current, truncated)
+	if truncated {
+		for id, prev := range previous {
+			if _, ok := current[id]; !ok {
+				current[id] = prev
+			}
+		}
+	}
+	return events, m.Store.Save(m.Name, current)
+}
+
+// Run checks the search immediately then every Interval and sends the changes on events
+// until ctx is done.
+func (m *SearchMonitor) Run(ctx context.Context, events chan<- SearchEvent) error {
+	interval := m.Interval
+	if interval <= 0 {
+		interval = 15 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		evts, err := m.Check(ctx)
+		if err != nil {
+			if m.ErrorHandler == nil {
+				return err
+			}
+			m.ErrorHandler(err)
+		}
+		for _, e := range evts {
+			select {
+			case events <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// diffSearch returns the changes between two runs of a search.
+// Ended items are not reported if current is truncated.
+func diffSearch(previous, current map[string]SearchSnapshotItem, truncated bool) []SearchEvent {
+	var events []SearchEvent
+	for id, cur := range current {
+		cur := cur
+		prev, ok := previous[id]
+		if !ok {
+			events = append(events, SearchEvent{Type: SearchEventNew, ItemID: id, Current: &cur})
+			continue
+		}
+		if !sameAmount(prev.Price, cur.Price) || !sameAmount(prev.CurrentBidPrice, cur.CurrentBidPrice) {
+			events = append(events, SearchEvent{Type: SearchEventPriceChanged, ItemID: id, Previous: &prev, Current: &cur})
+		}
+		if prev.BidCount != cur.BidCount {
+			events = append(events, SearchEvent{Type: SearchEventBidCountChanged, ItemID: id, Previous: &prev, Current: &cur})
+		}
+	}
+	for id, prev := range previous {
+		prev := prev
+		if _, ok := current[id]; !ok && !truncated {
+			events = append(events, SearchEvent{Type: SearchEventEnded, ItemID: id, Previous: &prev})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].ItemID < events[j].ItemID
+	})
+	return events
+}
+
+// sameAmount compares amounts numerically so that "1.0" and "1.00" are equal.
+func sameAmount(a, b Amount) bool {
+	if a.Currency != b.Currency {
+		return false
+	}
+	da, errA := a.Decimal()
+	db, errB := b.Decimal()
+	if errA != nil || errB != nil {
+		return a.Value == b.Value
+	}
+	return da.Cmp(db) == 0
+}
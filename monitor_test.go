@@ -0,0 +1,127 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchMonitorCheck(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	responses := []string{
+		`{"total": 2, "itemSummaries": [
+			{"itemId": "1", "price": {"value": "10.00", "currency": "USD"}},
+			{"itemId": "2", "price": {"value": "5.00", "currency": "USD"}, "bidCount": 1}
+		]}`,
+		`{"total": 2, "itemSummaries": [
+			{"itemId": "2", "price": {"value": "6.0", "currency": "USD"}, "bidCount": 2},
+			{"itemId": "3", "price": {"value": "1.00", "currency": "USD"}}
+		]}`,
+	}
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "drone", r.URL.Query().Get("q"))
+		fmt.Fprint(w, responses[0])
+		responses = responses[1:]
+	})
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	m := ebay.SearchMonitor{
+		Browse: client.Buy.Browse,
+		Store:  ebay.FileSearchStore{Dir: dir},
+		Name:   "drones/cheap",
+		Opts:   []ebay.Opt{ebay.OptBrowseSearch("drone")},
+	}
+	events, err := m.Check(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, ebay.SearchEventNew, events[0].Type)
+	assert.Equal(t, "1", events[0].ItemID)
+
+	events, err = m.Check(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(events))
+	assert.Equal(t, ebay.SearchEventEnded, events[0].Type)
+	assert.Equal(t, "1", events[0].ItemID)
+	assert.Nil(t, events[0].Current)
+	assert.Equal(t, ebay.SearchEventPriceChanged, events[1].Type)
+	assert.Equal(t, "5.00", events[1].Previous.Price.Value)
+	assert.Equal(t, "6.0", events[1].Current.Price.Value)
+	assert.Equal(t, ebay.SearchEventBidCountChanged, events[2].Type)
+	assert.Equal(t, 2, events[2].Current.BidCount)
+	assert.Equal(t, ebay.SearchEventNew, events[3].Type)
+	assert.Equal(t, "3", events[3].ItemID)
+
+	saved, err := ebay.FileSearchStore{Dir: dir}.Load("drones/cheap")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(saved))
+}
+
+func TestSearchMonitorRunDefaultInterval(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"total": 1, "itemSummaries": [{"itemId": "1"}]}`)
+	})
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	m := ebay.SearchMonitor{Browse: client.Buy.Browse, Store: ebay.FileSearchStore{Dir: dir}, Name: "drones"}
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan ebay.SearchEvent)
+	done := make(chan error)
+	go func() { done <- m.Run(ctx, events) }()
+	e := <-events
+	assert.Equal(t, "1", e.ItemID)
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
+
+func TestSearchMonitorCheckTruncated(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	responses := []string{
+		`{"total": 2, "itemSummaries": [{"itemId": "1"}, {"itemId": "2"}]}`,
+		`{"total": 20000, "itemSummaries": [{"itemId": "2"}, {"itemId": "3"}]}`,
+		`{"total": 2, "itemSummaries": [{"itemId": "1"}, {"itemId": "3"}]}`,
+	}
+	mux.HandleFunc("/buy/browse/v1/item_summary/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, responses[0])
+		responses = responses[1:]
+	})
+
+	dir, err := ioutil.TempDir("", "ebay")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	m := ebay.SearchMonitor{Browse: client.Buy.Browse, Store: ebay.FileSearchStore{Dir: dir}, Name: "drones"}
+	_, err = m.Check(context.Background())
+	assert.Nil(t, err)
+
+	// Item 1 is past the results that can be retrieved.
+	events, err := m.Check(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, ebay.SearchEventNew, events[0].Type)
+	assert.Equal(t, "3", events[0].ItemID)
+
+	events, err = m.Check(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, ebay.SearchEventEnded, events[0].Type)
+	assert.Equal(t, "2", events[0].ItemID)
+}
@@ -42,26 +42,17 @@ func OptBuyMarketplace(marketplaceID string) func(*http.Request) {
 
 // Bidding represents an eBay item bidding.
 type Bidding struct {
-	AuctionStatus  string    `json:"auctionStatus"`
-	AuctionEndDate time.Time `json:"auctionEndDate"`
-	ItemID         string    `json:"itemId"`
-	CurrentPrice   struct {
-		Value    string `json:"value"`
-		Currency string `json:"currency"`
-	} `json:"currentPrice"`
-	BidCount            int  `json:"bidCount"`
-	HighBidder          bool `json:"highBidder"`
-	ReservePriceMet     bool `json:"reservePriceMet"`
-	SuggestedBidAmounts []struct {
-		Value    string `json:"value"`
-		Currency string `json:"currency"`
-	} `json:"suggestedBidAmounts"`
-	CurrentProxyBid struct {
+	AuctionStatus       string    `json:"auctionStatus"`
+	AuctionEndDate      time.Time `json:"auctionEndDate"`
+	ItemID              string    `json:"itemId"`
+	CurrentPrice        Amount    `json:"currentPrice"`
+	BidCount            int       `json:"bidCount"`
+	HighBidder          bool      `json:"highBidder"`
+	ReservePriceMet     bool      `json:"reservePriceMet"`
+	SuggestedBidAmounts []Amount  `json:"suggestedBidAmounts"`
+	CurrentProxyBid     struct {
 		ProxyBidID string `json:"proxyBidId"`
-		MaxAmount  struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"maxAmount"`
+		MaxAmount  Amount `json:"maxAmount"`
 	} `json:"currentProxyBid"`
 }
 
@@ -92,6 +83,18 @@ type ProxyBid struct {
 	ProxyBidID string `json:"proxyBidId"`
 }
 
+// ProxyBidder places proxy bids on auction items.
+// It is implemented by *OfferService and by the wrappers adding safety checks around it.
+type ProxyBidder interface {
+	PlaceProxyBid(ctx context.Context, itemID, marketplaceID, maxAmount, currency string, userConsentAdultOnlyItem bool, opts ...Opt) (ProxyBid, error)
+}
+
+// BiddingGetter retrieves the bidding details of the buyer on auction items.
+// It is implemented by *OfferService.
+type BiddingGetter interface {
+	GetBidding(ctx context.Context, itemID, marketplaceID string, opts ...Opt) (Bidding, error)
+}
+
 // Some valid eBay error codes for the PlaceProxyBid method.
 //
 // eBay API docs: https://developer.ebay.com/api-docs/buy/offer/resources/bidding/methods/placeProxyBid#h2-error-codes
@@ -120,6 +123,15 @@ const (
 //
 // eBay API docs: https://developer.ebay.com/api-docs/buy/offer/resources/bidding/methods/placeProxyBid
 func (s *OfferService) PlaceProxyBid(ctx context.Context, itemID, marketplaceID, maxAmount, currency string, userConsentAdultOnlyItem bool, opts ...Opt) (ProxyBid, error) {
+	req, err := s.newPlaceProxyBidRequest(itemID, marketplaceID, maxAmount, currency, userConsentAdultOnlyItem, opts...)
+	if err != nil {
+		return ProxyBid{}, err
+	}
+	var bid ProxyBid
+	return bid, s.client.Do(ctx, req, &bid)
+}
+
+func (s *OfferService) newPlaceProxyBidRequest(itemID, marketplaceID, maxAmount, currency string, userConsentAdultOnlyItem bool, opts ...Opt) (*http.Request, error) {
 	type userConsent struct {
 		AdultOnlyItem bool `json:"adultOnlyItem,omitempty"`
 	}
@@ -139,10 +151,5 @@ func (s *OfferService) PlaceProxyBid(ctx context.Context, itemID, marketplaceID,
 	}
 	u := fmt.Sprintf("buy/offer/v1_beta/bidding/%s/place_proxy_bid", itemID)
 	opts = append(opts, OptBuyMarketplace(marketplaceID))
-	req, err := s.client.NewRequest(http.MethodPost, u, &pl, opts...)
-	if err != nil {
-		return ProxyBid{}, err
-	}
-	var bid ProxyBid
-	return bid, s.client.Do(ctx, req, &bid)
+	return s.client.NewRequest(http.MethodPost, u, &pl, opts...)
 }
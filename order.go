@@ -0,0 +1,465 @@
+package ebay
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// OrderService handles communication with the Order API.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/overview.html
+type OrderService service
+
+// ShippingAddress represents the address an order is shipped to.
+type ShippingAddress struct {
+	AddressLine1    string `json:"addressLine1,omitempty"`
+	AddressLine2    string `json:"addressLine2,omitempty"`
+	City            string `json:"city,omitempty"`
+	Country         string `json:"country,omitempty"`
+	County          string `json:"county,omitempty"`
+	PhoneNumber     string `json:"phoneNumber,omitempty"`
+	PostalCode      string `json:"postalCode,omitempty"`
+	Recipient       string `json:"recipient,omitempty"`
+	StateOrProvince string `json:"stateOrProvince,omitempty"`
+}
+
+// BillingAddress represents the billing address of a credit card.
+type BillingAddress struct {
+	AddressLine1    string `json:"addressLine1,omitempty"`
+	AddressLine2    string `json:"addressLine2,omitempty"`
+	City            string `json:"city,omitempty"`
+	Country         string `json:"country,omitempty"`
+	County          string `json:"county,omitempty"`
+	FirstName       string `json:"firstName,omitempty"`
+	LastName        string `json:"lastName,omitempty"`
+	PostalCode      string `json:"postalCode,omitempty"`
+	StateOrProvince string `json:"stateOrProvince,omitempty"`
+}
+
+// CreditCard represents the credit card used to pay for an order.
+type CreditCard struct {
+	AccountHolderName string         `json:"accountHolderName,omitempty"`
+	BillingAddress    BillingAddress `json:"billingAddress"`
+	Brand             string         `json:"brand,omitempty"`
+	CardNumber        string         `json:"cardNumber,omitempty"`
+	CVVNumber         string         `json:"cvvNumber,omitempty"`
+	ExpireMonth       int            `json:"expireMonth,omitempty"`
+	ExpireYear        int            `json:"expireYear,omitempty"`
+}
+
+// LineItemInput represents an item and the quantity to purchase.
+type LineItemInput struct {
+	ItemID   string `json:"itemId"`
+	Quantity int    `json:"quantity"`
+}
+
+// GuestCheckoutSessionRequest represents the information required to initiate a guest checkout session.
+type GuestCheckoutSessionRequest struct {
+	ContactEmail     string          `json:"contactEmail"`
+	ContactFirstName string          `json:"contactFirstName"`
+	ContactLastName  string          `json:"contactLastName"`
+	CreditCard       *CreditCard     `json:"creditCard,omitempty"`
+	LineItemInputs   []LineItemInput `json:"lineItemInputs"`
+	ShippingAddress  ShippingAddress `json:"shippingAddress"`
+}
+
+// CheckoutSessionRequest represents the information required to initiate a member checkout session.
+type CheckoutSessionRequest struct {
+	CreditCard      *CreditCard      `json:"creditCard,omitempty"`
+	LineItemInputs  []LineItemInput  `json:"lineItemInputs"`
+	ShippingAddress *ShippingAddress `json:"shippingAddress,omitempty"`
+}
+
+// PricingSummary represents the costs of a checkout session or purchase order.
+type PricingSummary struct {
+	Adjustment struct {
+		Amount Amount `json:"amount"`
+		Label  string `json:"label"`
+	} `json:"adjustment"`
+	DeliveryCost     Amount `json:"deliveryCost"`
+	DeliveryDiscount Amount `json:"deliveryDiscount"`
+	Fee              Amount `json:"fee"`
+	ImportCharges    Amount `json:"importCharges"`
+	ImportTax        struct {
+		Amount        Amount `json:"amount"`
+		ImportTaxType string `json:"importTaxType"`
+	} `json:"importTax"`
+	PriceDiscount Amount `json:"priceDiscount"`
+	PriceSubtotal Amount `json:"priceSubtotal"`
+	Tax           Amount `json:"tax"`
+	Total         Amount `json:"total"`
+}
+
+// CheckoutShippingOption represents a shipping option available for a line item.
+type CheckoutShippingOption struct {
+	ShippingOptionID         string    `json:"shippingOptionId"`
+	ShippingServiceCode      string    `json:"shippingServiceCode"`
+	ShippingCarrierCode      string    `json:"shippingCarrierCode"`
+	BaseDeliveryCost         Amount    `json:"baseDeliveryCost"`
+	DeliveryDiscount         Amount    `json:"deliveryDiscount"`
+	ImportCharges            Amount    `json:"importCharges"`
+	MinEstimatedDeliveryDate time.Time `json:"minEstimatedDeliveryDate"`
+	MaxEstimatedDeliveryDate time.Time `json:"maxEstimatedDeliveryDate"`
+	Selected                 bool      `json:"selected"`
+}
+
+// CheckoutLineItem represents an item being purchased in a checkout session.
+type CheckoutLineItem struct {
+	LineItemID       string `json:"lineItemId"`
+	ItemID           string `json:"itemId"`
+	Title            string `json:"title"`
+	ShortDescription string `json:"shortDescription"`
+	Image            struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"image"`
+	Quantity      int    `json:"quantity"`
+	BaseUnitPrice Amount `json:"baseUnitPrice"`
+	NetPrice      Amount `json:"netPrice"`
+	Seller        struct {
+		Username           string `json:"username"`
+		FeedbackPercentage string `json:"feedbackPercentage"`
+		FeedbackScore      int    `json:"feedbackScore"`
+	} `json:"seller"`
+	ShippingOptions []CheckoutShippingOption `json:"shippingOptions"`
+	Promotions      []struct {
+		Discount           Amount `json:"discount"`
+		Message            string `json:"message"`
+		PromotionCode      string `json:"promotionCode"`
+		PromotionType      string `json:"promotionType"`
+		DiscountPercentage string `json:"discountPercentage"`
+	} `json:"promotions"`
+}
+
+// Coupon represents a coupon applied to a checkout session.
+type Coupon struct {
+	Discount       Amount `json:"discount"`
+	Message        string `json:"message"`
+	RedemptionCode string `json:"redemptionCode"`
+}
+
+// CheckoutSession represents an eBay checkout session.
+type CheckoutSession struct {
+	CheckoutSessionID      string             `json:"checkoutSessionId"`
+	ExpirationDate         time.Time          `json:"expirationDate"`
+	LineItems              []CheckoutLineItem `json:"lineItems"`
+	PricingSummary         PricingSummary     `json:"pricingSummary"`
+	ShippingAddress        ShippingAddress    `json:"shippingAddress"`
+	AppliedCoupons         []Coupon           `json:"appliedCoupons"`
+	AcceptedPaymentMethods []struct {
+		PaymentMethodType   string `json:"paymentMethodType"`
+		PaymentMethodBrands []struct {
+			PaymentMethodBrandType string `json:"paymentMethodBrandType"`
+		} `json:"paymentMethodBrands"`
+	} `json:"acceptedPaymentMethods"`
+	ProvidedPaymentInstrument struct {
+		PaymentMethodType  string `json:"paymentMethodType"`
+		PaymentMethodBrand struct {
+			PaymentMethodBrandType string `json:"paymentMethodBrandType"`
+		} `json:"paymentMethodBrand"`
+		PaymentInstrumentReference struct {
+			LastFourDigitForCreditCard string `json:"lastFourDigitForCreditCard"`
+		} `json:"paymentInstrumentReference"`
+	} `json:"providedPaymentInstrument"`
+	Warnings []Error `json:"warnings"`
+}
+
+// PurchaseOrderSummary represents the purchase order created when placing an order.
+type PurchaseOrderSummary struct {
+	PurchaseOrderHref          string    `json:"purchaseOrderHref"`
+	PurchaseOrderID            string    `json:"purchaseOrderId"`
+	PurchaseOrderPaymentStatus string    `json:"purchaseOrderPaymentStatus"`
+	PurchaseOrderCreationDate  time.Time `json:"purchaseOrderCreationDate"`
+	Warnings                   []Error   `json:"warnings"`
+}
+
+// Valid values for the "purchaseOrderPaymentStatus" purchase order field.
+const (
+	PurchaseOrderPaymentStatusPending           = "PENDING"
+	PurchaseOrderPaymentStatusPaid              = "PAID"
+	PurchaseOrderPaymentStatusFailed            = "FAILED"
+	PurchaseOrderPaymentStatusPartiallyRefunded = "PARTIALLY_REFUNDED"
+	PurchaseOrderPaymentStatusFullyRefunded     = "FULLY_REFUNDED"
+)
+
+// PurchaseOrderLineItem represents an item of a purchase order.
+//
+// LineItemStatus and LineItemPaymentStatus track the fulfillment and the refund of the line item.
+// ShippingDetail holds the carrier and the delivery estimates: getPurchaseOrder returns neither
+// tracking numbers nor refund amounts per line item, only the RefundedAmount of the order.
+type PurchaseOrderLineItem struct {
+	LineItemID string `json:"lineItemId"`
+	ItemID     string `json:"itemId"`
+	Title      string `json:"title"`
+	Image      struct {
+		ImageURL string `json:"imageUrl"`
+	} `json:"image"`
+	Quantity              int    `json:"quantity"`
+	NetPrice              Amount `json:"netPrice"`
+	LineItemStatus        string `json:"lineItemStatus"`
+	LineItemPaymentStatus string `json:"lineItemPaymentStatus"`
+	Seller                struct {
+		Username           string `json:"username"`
+		FeedbackPercentage string `json:"feedbackPercentage"`
+		FeedbackScore      int    `json:"feedbackScore"`
+	} `json:"seller"`
+	ShippingDetail struct {
+		ShippingServiceCode      string    `json:"shippingServiceCode"`
+		ShippingCarrierCode      string    `json:"shippingCarrierCode"`
+		MinEstimatedDeliveryDate time.Time `json:"minEstimatedDeliveryDate"`
+		MaxEstimatedDeliveryDate time.Time `json:"maxEstimatedDeliveryDate"`
+	} `json:"shippingDetail"`
+	Promotions []struct {
+		Discount      Amount `json:"discount"`
+		Message       string `json:"message"`
+		PromotionCode string `json:"promotionCode"`
+		PromotionType string `json:"promotionType"`
+	} `json:"promotions"`
+}
+
+// PurchaseOrder represents an eBay purchase order.
+// RefundedAmount is the total refunded on the order.
+type PurchaseOrder struct {
+	PurchaseOrderID            string                  `json:"purchaseOrderId"`
+	PurchaseOrderCreationDate  time.Time               `json:"purchaseOrderCreationDate"`
+	PurchaseOrderPaymentStatus string                  `json:"purchaseOrderPaymentStatus"`
+	PurchaseOrderStatus        string                  `json:"purchaseOrderStatus"`
+	LineItems                  []PurchaseOrderLineItem `json:"lineItems"`
+	PricingSummary             PricingSummary          `json:"pricingSummary"`
+	RefundedAmount             Amount                  `json:"refundedAmount"`
+	ShipToAddress              ShippingAddress         `json:"shipToAddress"`
+	Warnings                   []Error                 `json:"warnings"`
+}
+
+// InitiateGuestCheckoutSession creates a guest checkout session for the specified line items.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/initiateGuestCheckoutSession
+func (s *OrderService) InitiateGuestCheckoutSession(ctx context.Context, marketplaceID string, session GuestCheckoutSessionRequest, opts ...Opt) (CheckoutSession, error) {
+	u := "buy/order/v1/guest_checkout_session/initiate"
+	opts = append(opts, OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodPost, u, &session, opts...)
+	if err != nil {
+		return CheckoutSession{}, err
+	}
+	var cs CheckoutSession
+	return cs, s.client.Do(ctx, req, &cs)
+}
+
+// GetGuestCheckoutSession retrieves a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/getGuestCheckoutSession
+func (s *OrderService) GetGuestCheckoutSession(ctx context.Context, checkoutSessionID string, opts ...Opt) (CheckoutSession, error) {
+	u := fmt.Sprintf("buy/order/v1/guest_checkout_session/%s", checkoutSessionID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return CheckoutSession{}, err
+	}
+	var cs CheckoutSession
+	return cs, s.client.Do(ctx, req, &cs)
+}
+
+func (s *OrderService) updateCheckoutSession(ctx context.Context, resource, checkoutSessionID, action string, body interface{}, opts []Opt) (CheckoutSession, error) {
+	u := fmt.Sprintf("buy/order/v1/%s/%s/%s", resource, checkoutSessionID, action)
+	req, err := s.client.NewRequest(http.MethodPost, u, body, opts...)
+	if err != nil {
+		return CheckoutSession{}, err
+	}
+	var cs CheckoutSession
+	return cs, s.client.Do(ctx, req, &cs)
+}
+
+type quantityUpdate struct {
+	LineItemID string `json:"lineItemId"`
+	Quantity   int    `json:"quantity"`
+}
+
+type shippingOptionUpdate struct {
+	LineItemID       string `json:"lineItemId"`
+	ShippingOptionID string `json:"shippingOptionId"`
+}
+
+type couponRequest struct {
+	RedemptionCode string `json:"redemptionCode"`
+}
+
+type paymentInfoUpdate struct {
+	CreditCard CreditCard `json:"creditCard"`
+}
+
+// UpdateGuestQuantity changes the quantity of a line item of a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/updateGuestQuantity
+func (s *OrderService) UpdateGuestQuantity(ctx context.Context, checkoutSessionID, lineItemID string, quantity int, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "guest_checkout_session", checkoutSessionID, "update_quantity",
+		&quantityUpdate{lineItemID, quantity}, opts)
+}
+
+// UpdateGuestShippingAddress changes the shipping address of a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/updateGuestShippingAddress
+func (s *OrderService) UpdateGuestShippingAddress(ctx context.Context, checkoutSessionID string, address ShippingAddress, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "guest_checkout_session", checkoutSessionID, "update_shipping_address",
+		&address, opts)
+}
+
+// UpdateGuestShippingOption changes the shipping option of a line item of a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/updateGuestShippingOption
+func (s *OrderService) UpdateGuestShippingOption(ctx context.Context, checkoutSessionID, lineItemID, shippingOptionID string, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "guest_checkout_session", checkoutSessionID, "update_shipping_option",
+		&shippingOptionUpdate{lineItemID, shippingOptionID}, opts)
+}
+
+// ApplyGuestCoupon applies a coupon to a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/applyGuestCoupon
+func (s *OrderService) ApplyGuestCoupon(ctx context.Context, checkoutSessionID, redemptionCode string, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "guest_checkout_session", checkoutSessionID, "apply_coupon",
+		&couponRequest{redemptionCode}, opts)
+}
+
+// RemoveGuestCoupon removes a coupon from a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/removeGuestCoupon
+func (s *OrderService) RemoveGuestCoupon(ctx context.Context, checkoutSessionID, redemptionCode string, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "guest_checkout_session", checkoutSessionID, "remove_coupon",
+		&couponRequest{redemptionCode}, opts)
+}
+
+// UpdateGuestPaymentInfo changes the credit card used to pay for a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/updateGuestPaymentInfo
+func (s *OrderService) UpdateGuestPaymentInfo(ctx context.Context, checkoutSessionID string, card CreditCard, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "guest_checkout_session", checkoutSessionID, "update_payment_info",
+		&paymentInfoUpdate{card}, opts)
+}
+
+// PlaceGuestOrder completes a guest checkout session and creates the purchase order.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_checkout_session/methods/placeGuestOrder
+func (s *OrderService) PlaceGuestOrder(ctx context.Context, checkoutSessionID string, opts ...Opt) (PurchaseOrderSummary, error) {
+	u := fmt.Sprintf("buy/order/v1/guest_checkout_session/%s/place_order", checkoutSessionID)
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, opts...)
+	if err != nil {
+		return PurchaseOrderSummary{}, err
+	}
+	var po PurchaseOrderSummary
+	return po, s.client.Do(ctx, req, &po)
+}
+
+// GetGuestPurchaseOrder retrieves a purchase order created by a guest checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/guest_purchase_order/methods/getGuestPurchaseOrder
+func (s *OrderService) GetGuestPurchaseOrder(ctx context.Context, purchaseOrderID string, opts ...Opt) (PurchaseOrder, error) {
+	u := fmt.Sprintf("buy/order/v1/guest_purchase_order/%s", purchaseOrderID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return PurchaseOrder{}, err
+	}
+	var po PurchaseOrder
+	return po, s.client.Do(ctx, req, &po)
+}
+
+// InitiateCheckoutSession creates a checkout session for the authenticated buyer.
+// The shipping address and credit card default to the ones of the buyer's eBay account.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/initiateCheckoutSession
+func (s *OrderService) InitiateCheckoutSession(ctx context.Context, marketplaceID string, session CheckoutSessionRequest, opts ...Opt) (CheckoutSession, error) {
+	u := "buy/order/v1/checkout_session/initiate"
+	opts = append(opts, OptBuyMarketplace(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodPost, u, &session, opts...)
+	if err != nil {
+		return CheckoutSession{}, err
+	}
+	var cs CheckoutSession
+	return cs, s.client.Do(ctx, req, &cs)
+}
+
+// GetCheckoutSession retrieves a checkout session of the authenticated buyer.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/getCheckoutSession
+func (s *OrderService) GetCheckoutSession(ctx context.Context, checkoutSessionID string, opts ...Opt) (CheckoutSession, error) {
+	u := fmt.Sprintf("buy/order/v1/checkout_session/%s", checkoutSessionID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return CheckoutSession{}, err
+	}
+	var cs CheckoutSession
+	return cs, s.client.Do(ctx, req, &cs)
+}
+
+// UpdateQuantity changes the quantity of a line item of a checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/updateQuantity
+func (s *OrderService) UpdateQuantity(ctx context.Context, checkoutSessionID, lineItemID string, quantity int, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "checkout_session", checkoutSessionID, "update_quantity",
+		&quantityUpdate{lineItemID, quantity}, opts)
+}
+
+// UpdateShippingAddress changes the shipping address of a checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/updateShippingAddress
+func (s *OrderService) UpdateShippingAddress(ctx context.Context, checkoutSessionID string, address ShippingAddress, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "checkout_session", checkoutSessionID, "update_shipping_address",
+		&address, opts)
+}
+
+// UpdateShippingOption changes the shipping option of a line item of a checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/updateShippingOption
+func (s *OrderService) UpdateShippingOption(ctx context.Context, checkoutSessionID, lineItemID, shippingOptionID string, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "checkout_session", checkoutSessionID, "update_shipping_option",
+		&shippingOptionUpdate{lineItemID, shippingOptionID}, opts)
+}
+
+// ApplyCoupon applies a coupon to a checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/applyCoupon
+func (s *OrderService) ApplyCoupon(ctx context.Context, checkoutSessionID, redemptionCode string, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "checkout_session", checkoutSessionID, "apply_coupon",
+		&couponRequest{redemptionCode}, opts)
+}
+
+// RemoveCoupon removes a coupon from a checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/removeCoupon
+func (s *OrderService) RemoveCoupon(ctx context.Context, checkoutSessionID, redemptionCode string, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "checkout_session", checkoutSessionID, "remove_coupon",
+		&couponRequest{redemptionCode}, opts)
+}
+
+// UpdatePaymentInfo changes the credit card used to pay for a checkout session.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/updatePaymentInfo
+func (s *OrderService) UpdatePaymentInfo(ctx context.Context, checkoutSessionID string, card CreditCard, opts ...Opt) (CheckoutSession, error) {
+	return s.updateCheckoutSession(ctx, "checkout_session", checkoutSessionID, "update_payment_info",
+		&paymentInfoUpdate{card}, opts)
+}
+
+// PlaceOrder completes a checkout session and creates the purchase order.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/checkout_session/methods/placeOrder
+func (s *OrderService) PlaceOrder(ctx context.Context, checkoutSessionID string, opts ...Opt) (PurchaseOrderSummary, error) {
+	u := fmt.Sprintf("buy/order/v1/checkout_session/%s/place_order", checkoutSessionID)
+	req, err := s.client.NewRequest(http.MethodPost, u, nil, opts...)
+	if err != nil {
+		return PurchaseOrderSummary{}, err
+	}
+	var po PurchaseOrderSummary
+	return po, s.client.Do(ctx, req, &po)
+}
+
+// GetPurchaseOrder retrieves a purchase order of the authenticated buyer,
+// including the status, fulfillment and refunds of its line items.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/buy/order/resources/purchase_order/methods/getPurchaseOrder
+func (s *OrderService) GetPurchaseOrder(ctx context.Context, purchaseOrderID string, opts ...Opt) (PurchaseOrder, error) {
+	u := fmt.Sprintf("buy/order/v1/purchase_order/%s", purchaseOrderID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return PurchaseOrder{}, err
+	}
+	var po PurchaseOrder
+	return po, s.client.Do(ctx, req, &po)
+}
@@ -0,0 +1,223 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInitiateGuestCheckoutSession(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/order/v1/guest_checkout_session/initiate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		assert.Equal(t, `{"contactEmail":"a@b.c","contactFirstName":"A","contactLastName":"B","lineItemInputs":[{"itemId":"v1|202117468662|0","quantity":2}],"shippingAddress":{"city":"San Jose","country":"US"}}
+`, string(body))
+		fmt.Fprint(w, `{"checkoutSessionId": "session", "lineItems": [{"lineItemId": "line", "shippingOptions": [{"shippingOptionId": "opt"}]}],
+			"pricingSummary": {"total": {"value": "10.00", "currency": "USD"}}}`)
+	})
+
+	session, err := client.Buy.Order.InitiateGuestCheckoutSession(context.Background(), ebay.BuyMarketplaceUSA, ebay.GuestCheckoutSessionRequest{
+		ContactEmail:     "a@b.c",
+		ContactFirstName: "A",
+		ContactLastName:  "B",
+		LineItemInputs:   []ebay.LineItemInput{{ItemID: "v1|202117468662|0", Quantity: 2}},
+		ShippingAddress:  ebay.ShippingAddress{City: "San Jose", Country: "US"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "session", session.CheckoutSessionID)
+	assert.Equal(t, "opt", session.LineItems[0].ShippingOptions[0].ShippingOptionID)
+	assert.Equal(t, "10.00", session.PricingSummary.Total.Value)
+}
+
+func TestGetGuestCheckoutSession(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/order/v1/guest_checkout_session/session", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"checkoutSessionId": "session"}`)
+	})
+
+	session, err := client.Buy.Order.GetGuestCheckoutSession(context.Background(), "session")
+	assert.Nil(t, err)
+	assert.Equal(t, "session", session.CheckoutSessionID)
+}
+
+func TestUpdateGuestCheckoutSession(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	bodies := map[string]string{}
+	mux.HandleFunc("/buy/order/v1/guest_checkout_session/session/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies[r.URL.Path] = string(body)
+		fmt.Fprint(w, `{"checkoutSessionId": "session"}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Buy.Order.UpdateGuestQuantity(ctx, "session", "line", 3)
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.UpdateGuestShippingAddress(ctx, "session", ebay.ShippingAddress{PostalCode: "95125"})
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.UpdateGuestShippingOption(ctx, "session", "line", "opt")
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.ApplyGuestCoupon(ctx, "session", "CODE")
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.RemoveGuestCoupon(ctx, "session", "CODE")
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.UpdateGuestPaymentInfo(ctx, "session", ebay.CreditCard{CardNumber: "4111"})
+	assert.Nil(t, err)
+
+	prefix := "/buy/order/v1/guest_checkout_session/session/"
+	assert.Equal(t, `{"lineItemId":"line","quantity":3}`+"\n", bodies[prefix+"update_quantity"])
+	assert.Equal(t, `{"postalCode":"95125"}`+"\n", bodies[prefix+"update_shipping_address"])
+	assert.Equal(t, `{"lineItemId":"line","shippingOptionId":"opt"}`+"\n", bodies[prefix+"update_shipping_option"])
+	assert.Equal(t, `{"redemptionCode":"CODE"}`+"\n", bodies[prefix+"apply_coupon"])
+	assert.Equal(t, `{"redemptionCode":"CODE"}`+"\n", bodies[prefix+"remove_coupon"])
+	assert.Equal(t, `{"creditCard":{"billingAddress":{},"cardNumber":"4111"}}`+"\n", bodies[prefix+"update_payment_info"])
+}
+
+func TestPlaceGuestOrder(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/order/v1/guest_checkout_session/session/place_order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"purchaseOrderId": "order", "purchaseOrderPaymentStatus": "PAID"}`)
+	})
+	mux.HandleFunc("/buy/order/v1/guest_purchase_order/order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"purchaseOrderId": "order", "lineItems": [{"lineItemStatus": "PENDING"}]}`)
+	})
+
+	summary, err := client.Buy.Order.PlaceGuestOrder(context.Background(), "session")
+	assert.Nil(t, err)
+	assert.Equal(t, "order", summary.PurchaseOrderID)
+	assert.Equal(t, "PAID", summary.PurchaseOrderPaymentStatus)
+
+	order, err := client.Buy.Order.GetGuestPurchaseOrder(context.Background(), "order")
+	assert.Nil(t, err)
+	assert.Equal(t, "PENDING", order.LineItems[0].LineItemStatus)
+}
+
+func TestInitiateCheckoutSession(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/order/v1/checkout_session/initiate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		assert.Equal(t, `{"lineItemInputs":[{"itemId":"v1|202117468662|0","quantity":1}]}
+`, string(body))
+		fmt.Fprint(w, `{"checkoutSessionId": "session"}`)
+	})
+
+	session, err := client.Buy.Order.InitiateCheckoutSession(context.Background(), ebay.BuyMarketplaceUSA, ebay.CheckoutSessionRequest{
+		LineItemInputs: []ebay.LineItemInput{{ItemID: "v1|202117468662|0", Quantity: 1}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "session", session.CheckoutSessionID)
+}
+
+func TestUpdateCheckoutSession(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	var paths []string
+	mux.HandleFunc("/buy/order/v1/checkout_session/session/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"checkoutSessionId": "session"}`)
+	})
+
+	ctx := context.Background()
+	_, err := client.Buy.Order.UpdateQuantity(ctx, "session", "line", 3)
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.UpdateShippingAddress(ctx, "session", ebay.ShippingAddress{PostalCode: "95125"})
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.UpdateShippingOption(ctx, "session", "line", "opt")
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.ApplyCoupon(ctx, "session", "CODE")
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.RemoveCoupon(ctx, "session", "CODE")
+	assert.Nil(t, err)
+	_, err = client.Buy.Order.UpdatePaymentInfo(ctx, "session", ebay.CreditCard{CardNumber: "4111"})
+	assert.Nil(t, err)
+
+	prefix := "/buy/order/v1/checkout_session/session/"
+	assert.Equal(t, []string{prefix + "update_quantity", prefix + "update_shipping_address",
+		prefix + "update_shipping_option", prefix + "apply_coupon", prefix + "remove_coupon",
+		prefix + "update_payment_info"}, paths)
+}
+
+func TestPlaceOrder(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/order/v1/checkout_session/session/place_order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Fatalf("expected POST method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"purchaseOrderId": "order", "purchaseOrderPaymentStatus": "PAID"}`)
+	})
+	mux.HandleFunc("/buy/order/v1/purchase_order/order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, `{"purchaseOrderId": "order", "purchaseOrderPaymentStatus": "PARTIALLY_REFUNDED",
+			"refundedAmount": {"value": "5.00", "currency": "USD"},
+			"lineItems": [{"lineItemId": "1", "lineItemStatus": "FULFILLED", "lineItemPaymentStatus": "REFUNDED",
+				"shippingDetail": {"shippingCarrierCode": "USPS", "shippingServiceCode": "USPSPriority",
+					"maxEstimatedDeliveryDate": "2019-06-05T07:00:00.000Z"},
+				"promotions": [{"discount": {"value": "1.00", "currency": "USD"}, "promotionType": "CODED_COUPON"}]}]}`)
+	})
+
+	summary, err := client.Buy.Order.PlaceOrder(context.Background(), "session")
+	assert.Nil(t, err)
+	assert.Equal(t, "order", summary.PurchaseOrderID)
+
+	order, err := client.Buy.Order.GetPurchaseOrder(context.Background(), "order")
+	assert.Nil(t, err)
+	assert.Equal(t, ebay.PurchaseOrderPaymentStatusPartiallyRefunded, order.PurchaseOrderPaymentStatus)
+	assert.Equal(t, ebay.Amount{Value: "5.00", Currency: "USD"}, order.RefundedAmount)
+	assert.Equal(t, "FULFILLED", order.LineItems[0].LineItemStatus)
+	line := order.LineItems[0]
+	assert.Equal(t, "1", line.LineItemID)
+	assert.Equal(t, "REFUNDED", line.LineItemPaymentStatus)
+	assert.Equal(t, "USPS", line.ShippingDetail.ShippingCarrierCode)
+	assert.Equal(t, "USPSPriority", line.ShippingDetail.ShippingServiceCode)
+	assert.Equal(t, time.Date(2019, 6, 5, 7, 0, 0, 0, time.UTC), line.ShippingDetail.MaxEstimatedDeliveryDate)
+	assert.Equal(t, ebay.Amount{Value: "1.00", Currency: "USD"}, line.Promotions[0].Discount)
+}
@@ -0,0 +1,108 @@
+package ebay
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Valid values for the "regionType" ship to location field.
+const (
+	BrowseRegionTypeCountry       = "COUNTRY"
+	BrowseRegionTypeCountryRegion = "COUNTRY_REGION"
+	BrowseRegionTypeStateOrProv   = "STATE_OR_PROVINCE"
+	BrowseRegionTypeWorldRegion   = "WORLD_REGION"
+	BrowseRegionTypeWorldwide     = "WORLDWIDE"
+)
+
+// Destination represents a location an item can be shipped to.
+// Country is the two-letter ISO 3166 code representing the country.
+type Destination struct {
+	Country    string
+	PostalCode string
+}
+
+// ShippingEstimate represents the cheapest way to ship an item to a destination.
+// Err is set if the item could not be retrieved for the destination.
+type ShippingEstimate struct {
+	Destination              Destination
+	ShipToEligible           bool
+	ShippingServiceCode      string
+	ShippingCost             Amount
+	MinEstimatedDeliveryDate time.Time
+	MaxEstimatedDeliveryDate time.Time
+	Err                      error
+}
+
+// EstimateShipping concurrently retrieves an item as seen from each destination
+// and returns one ShippingEstimate per destination, in the same order.
+func (s *BrowseService) EstimateShipping(ctx context.Context, itemID string, destinations []Destination, opts ...Opt) []ShippingEstimate {
+	estimates := make([]ShippingEstimate, len(destinations))
+	var wg sync.WaitGroup
+	for i, dest := range destinations {
+		wg.Add(1)
+		go func(i int, dest Destination) {
+			defer wg.Done()
+			destOpts := append(opts[:len(opts):len(opts)], OptBrowseContextualLocation(dest.Country, dest.PostalCode))
+			it, err := s.GetItem(ctx, itemID, destOpts...)
+			if err != nil {
+				estimates[i] = ShippingEstimate{Destination: dest, Err: err}
+				return
+			}
+			estimates[i], err = estimateShipping(it, dest)
+			estimates[i].Err = err
+		}(i, dest)
+	}
+	wg.Wait()
+	return estimates
+}
+
+func estimateShipping(it Item, dest Destination) (ShippingEstimate, error) {
+	est := ShippingEstimate{Destination: dest, ShipToEligible: ShipsTo(it, dest.Country)}
+	var cheapest Decimal
+	found := false
+	for _, opt := range it.ShippingOptions {
+		cost, err := opt.ShippingCost.Decimal()
+		if err != nil {
+			return est, err
+		}
+		if found {
+			if c := cost.Cmp(cheapest); c > 0 || c == 0 && !opt.MaxEstimatedDeliveryDate.Before(est.MaxEstimatedDeliveryDate) {
+				continue
+			}
+		}
+		found = true
+		cheapest = cost
+		est.ShippingServiceCode = opt.ShippingServiceCode
+		est.ShippingCost = opt.ShippingCost
+		est.MinEstimatedDeliveryDate = opt.MinEstimatedDeliveryDate
+		est.MaxEstimatedDeliveryDate = opt.MaxEstimatedDeliveryDate
+	}
+	return est, nil
+}
+
+// ShipsTo reports whether the item can be shipped to country according to its ship to locations.
+// Country is the two-letter ISO 3166 code representing the country.
+//
+// Included regions that are not countries can't be resolved locally, in that case
+// ShipsTo relies on the shipping options eBay estimated for the item's contextual location.
+func ShipsTo(it Item, country string) bool {
+	for _, r := range it.ShipToLocations.RegionExcluded {
+		if r.RegionType == BrowseRegionTypeCountry && strings.EqualFold(r.RegionID, country) {
+			return false
+		}
+	}
+	for _, r := range it.ShipToLocations.RegionIncluded {
+		if r.RegionType == BrowseRegionTypeWorldwide ||
+			r.RegionType == BrowseRegionTypeCountry && strings.EqualFold(r.RegionID, country) {
+			return true
+		}
+	}
+	for _, opt := range it.ShippingOptions {
+		if strings.EqualFold(opt.ShipToLocationUsedForEstimate.Country, country) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateShipping(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/browse/v1/item/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Header.Get("X-EBAY-C-ENDUSERCTX")
+		switch {
+		case strings.Contains(ctx, "country%3DUS"):
+			fmt.Fprint(w, `{
+				"shippingOptions": [
+					{"shippingServiceCode": "Expedited", "shippingCost": {"value": "12.00", "currency": "USD"},
+					 "minEstimatedDeliveryDate": "2019-06-01T00:00:00Z", "maxEstimatedDeliveryDate": "2019-06-02T00:00:00Z"},
+					{"shippingServiceCode": "Standard", "shippingCost": {"value": "4.00", "currency": "USD"},
+					 "minEstimatedDeliveryDate": "2019-06-03T00:00:00Z", "maxEstimatedDeliveryDate": "2019-06-07T00:00:00Z"}
+				],
+				"shipToLocations": {"regionIncluded": [{"regionId": "US", "regionType": "COUNTRY"}]}
+			}`)
+		case strings.Contains(ctx, "country%3DFR"):
+			fmt.Fprint(w, `{"shipToLocations": {
+				"regionIncluded": [{"regionType": "WORLDWIDE"}],
+				"regionExcluded": [{"regionId": "FR", "regionType": "COUNTRY"}]
+			}}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	estimates := client.Buy.Browse.EstimateShipping(context.Background(), "v1|202117468662|0", []ebay.Destination{
+		{Country: "US", PostalCode: "94105"},
+		{Country: "FR", PostalCode: "75001"},
+		{Country: "XX", PostalCode: "0"},
+	})
+	assert.Equal(t, 3, len(estimates))
+
+	assert.Nil(t, estimates[0].Err)
+	assert.Equal(t, "94105", estimates[0].Destination.PostalCode)
+	assert.True(t, estimates[0].ShipToEligible)
+	assert.Equal(t, "Standard", estimates[0].ShippingServiceCode)
+	assert.Equal(t, ebay.Amount{Value: "4.00", Currency: "USD"}, estimates[0].ShippingCost)
+	assert.Equal(t, 7, estimates[0].MaxEstimatedDeliveryDate.Day())
+
+	assert.Nil(t, estimates[1].Err)
+	assert.False(t, estimates[1].ShipToEligible)
+
+	assert.NotNil(t, estimates[2].Err)
+}
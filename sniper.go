@@ -0,0 +1,209 @@
+package ebay
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Snipe describes a last-second proxy bid.
+// See OfferService.PlaceProxyBid for the meaning of each field.
+type Snipe struct {
+	ItemID                   string
+	MarketplaceID            string
+	MaxAmount                string
+	Currency                 string
+	UserConsentAdultOnlyItem bool
+}
+
+// SnipeOutcome describes how a snipe ended.
+type SnipeOutcome string
+
+// Valid values for SnipeOutcome.
+const (
+	SnipeOutcomeWon          SnipeOutcome = "WON"
+	SnipeOutcomeLost         SnipeOutcome = "LOST"
+	SnipeOutcomeAuctionEnded SnipeOutcome = "AUCTION_ENDED" // The auction ended before the bid was placed.
+	SnipeOutcomeOutbid       SnipeOutcome = "OUTBID"        // The current price already exceeded the maximum amount.
+	SnipeOutcomeBidTooLow    SnipeOutcome = "BID_TOO_LOW"
+	SnipeOutcomeBidTooHigh   SnipeOutcome = "BID_TOO_HIGH"
+	SnipeOutcomeBidInvalid   SnipeOutcome = "BID_INVALID"
+)
+
+// SnipeResult represents the result of a snipe.
+type SnipeResult struct {
+	Outcome SnipeOutcome
+	// ProxyBid is set if the bid was placed.
+	ProxyBid ProxyBid
+	// BidPlacedAt is the local time the bid was placed at.
+	BidPlacedAt time.Time
+	// Bidding is the last bidding state retrieved.
+	Bidding Bidding
+	// Err is the eBay error that prevented the bid from being placed, if any.
+	Err error
+}
+
+// Sniper places proxy bids right before auctions end.
+type Sniper struct {
+	// Offer retrieves the bidding details of the auction, usually the OfferService.
+	Offer BiddingGetter
+
+	// Bidder places the proxy bid. Wrap the OfferService in an OfferGuard, a ConsentingBidder
+	// or a LedgerBidder to enforce limits, check consent or record the bid.
+	Bidder ProxyBidder
+
+	// Browse, if set, is used to retrieve the auction end date
+	// when the buyer has no bidding activity on the item yet.
+	Browse *BrowseService
+
+	// Clock defaults to the system clock.
+	Clock Clock
+
+	// LeadTime is how long before the end of the auction the bid is placed.
+	// Defaults to 5 seconds.
+	LeadTime time.Duration
+
+	// ClockSkew is how far the local clock is ahead of eBay's clock.
+	ClockSkew time.Duration
+
+	// SettleDelay is how long to wait after the end of the auction before checking
+	// whether it was won, and between subsequent checks. Defaults to 10 seconds.
+	SettleDelay time.Duration
+}
+
+// Snipe waits until LeadTime before the end of the auction, checks the auction is still worth
+// bidding on, places the proxy bid and waits for the end of the auction to report its outcome.
+//
+// An error is returned if the end date of the auction is unknown, and SnipeOutcomeAuctionEnded
+// without bidding if the auction is already over.
+// eBay errors returned when placing the bid are mapped to an outcome and reported in SnipeResult.Err.
+// Other errors, including a bid refused by the Bidder, are returned.
+func (s *Sniper) Snipe(ctx context.Context, snipe Snipe) (SnipeResult, error) {
+	clock := clockOrSystem(s.Clock)
+	leadTime := s.LeadTime
+	if leadTime <= 0 {
+		leadTime = 5 * time.Second
+	}
+	settleDelay := s.SettleDelay
+	if settleDelay <= 0 {
+		settleDelay = 10 * time.Second
+	}
+
+	end, err := s.auctionEndDate(ctx, snipe)
+	if err != nil {
+		return SnipeResult{}, err
+	}
+	if !clock.Now().Before(end.Add(s.ClockSkew)) {
+		// The auction is over, firing now would bid on an ended auction.
+		return SnipeResult{Outcome: SnipeOutcomeAuctionEnded}, nil
+	}
+	fireAt := end.Add(-leadTime).Add(s.ClockSkew)
+	if err := sleep(ctx, clock, fireAt.Sub(clock.Now())); err != nil {
+		return SnipeResult{}, err
+	}
+
+	var result SnipeResult
+	bidding, err := s.Offer.GetBidding(ctx, snipe.ItemID, snipe.MarketplaceID)
+	switch {
+	case err == nil:
+		result.Bidding = bidding
+		if bidding.AuctionStatus == BiddingAuctionStatusEnded {
+			result.Outcome = SnipeOutcomeAuctionEnded
+			return result, nil
+		}
+		if outbid(bidding, snipe) {
+			result.Outcome = SnipeOutcomeOutbid
+			return result, nil
+		}
+	case !IsError(err, ErrGetBiddingNoBiddingActivity):
+		return result, err
+	}
+
+	result.ProxyBid, err = s.Bidder.PlaceProxyBid(ctx, snipe.ItemID, snipe.MarketplaceID,
+		snipe.MaxAmount, snipe.Currency, snipe.UserConsentAdultOnlyItem)
+	result.BidPlacedAt = clock.Now()
+	if err != nil {
+		outcome, ok := snipeOutcome(err)
+		if !ok {
+			return result, err
+		}
+		result.Outcome = outcome
+		result.Err = err
+		return result, nil
+	}
+
+	if err := sleep(ctx, clock, end.Add(s.ClockSkew).Sub(clock.Now())); err != nil {
+		return result, err
+	}
+	for {
+		if err := sleep(ctx, clock, settleDelay); err != nil {
+			return result, err
+		}
+		bidding, err := s.Offer.GetBidding(ctx, snipe.ItemID, snipe.MarketplaceID)
+		if err != nil {
+			return result, err
+		}
+		result.Bidding = bidding
+		if bidding.AuctionStatus != BiddingAuctionStatusEnded {
+			continue
+		}
+		result.Outcome = SnipeOutcomeLost
+		if bidding.HighBidder {
+			result.Outcome = SnipeOutcomeWon
+		}
+		return result, nil
+	}
+}
+
+// auctionEndDate returns an error if the end date is unknown, since the sniper would bid immediately.
+func (s *Sniper) auctionEndDate(ctx context.Context, snipe Snipe) (time.Time, error) {
+	bidding, err := s.Offer.GetBidding(ctx, snipe.ItemID, snipe.MarketplaceID)
+	end := bidding.AuctionEndDate
+	if err != nil {
+		if s.Browse == nil || !IsError(err, ErrGetBiddingNoBiddingActivity) {
+			return time.Time{}, err
+		}
+		it, err := s.Browse.GetItem(ctx, snipe.ItemID, OptBuyMarketplace(snipe.MarketplaceID))
+		if err != nil {
+			return time.Time{}, err
+		}
+		end = it.ItemEndDate
+	}
+	if end.IsZero() {
+		return time.Time{}, errors.Errorf("end date of auction %s is unknown", snipe.ItemID)
+	}
+	return end, nil
+}
+
+// outbid reports whether the current price of the auction already exceeds the snipe maximum amount.
+func outbid(bidding Bidding, snipe Snipe) bool {
+	if bidding.HighBidder || bidding.CurrentPrice.Currency != snipe.Currency {
+		return false
+	}
+	price, err := bidding.CurrentPrice.Decimal()
+	if err != nil {
+		return false
+	}
+	max, err := ParseDecimal(snipe.MaxAmount)
+	if err != nil {
+		return false
+	}
+	return price.Cmp(max) >= 0
+}
+
+func snipeOutcome(err error) (SnipeOutcome, bool) {
+	switch {
+	case IsError(err, ErrPlaceProxyBidAuctionEndedBecauseOfBuyItNow, ErrPlaceProxyBidAuctionHasEnded):
+		return SnipeOutcomeAuctionEnded, true
+	case IsError(err, ErrPlaceProxyBidAmountTooLow, ErrPlaceProxyBidCannotLowerYourProxyBid):
+		return SnipeOutcomeBidTooLow, true
+	case IsError(err, ErrPlaceProxyBidAmountTooHigh, ErrPlaceProxyBidAmountExceedsLimit,
+		ErrPlaceProxyBidBidCannotBeGreaterThanBuyItNowPrice):
+		return SnipeOutcomeBidTooHigh, true
+	case IsError(err, ErrPlaceProxyBidAmountInvalid, ErrPlaceProxyBidCurrencyInvalid,
+		ErrPlaceProxyBidCurrencyMustMatchItemPriceCurrency, ErrPlaceProxyBidMaximumBidAmountMissing):
+		return SnipeOutcomeBidInvalid, true
+	}
+	return "", false
+}
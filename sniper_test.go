@@ -0,0 +1,183 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a Clock whose timers fire immediately and advance the current time.
+type fakeClock struct {
+	now   time.Time
+	waits []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waits = append(c.waits, d)
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func TestSnipeWon(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	end := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: end.Add(-time.Hour)}
+	bidPlaced := false
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		status := "ACTIVE"
+		if !clock.now.Before(end) {
+			status = ebay.BiddingAuctionStatusEnded
+		}
+		fmt.Fprintf(w, `{"auctionStatus": "%s", "auctionEndDate": "%s", "highBidder": %t,
+			"currentPrice": {"value": "10.00", "currency": "USD"}}`, status, end.Format(time.RFC3339), bidPlaced)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, end.Add(-3*time.Second), clock.now)
+		bidPlaced = true
+		fmt.Fprint(w, `{"proxyBidId": "123"}`)
+	})
+
+	sniper := ebay.Sniper{
+		Offer:     client.Buy.Offer,
+		Bidder:    client.Buy.Offer,
+		Clock:     clock,
+		LeadTime:  4 * time.Second,
+		ClockSkew: time.Second,
+	}
+	result, err := sniper.Snipe(context.Background(), ebay.Snipe{
+		ItemID:        "v1|202117468662|0",
+		MarketplaceID: ebay.BuyMarketplaceUSA,
+		MaxAmount:     "20.00",
+		Currency:      "USD",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ebay.SnipeOutcomeWon, result.Outcome)
+	assert.Equal(t, "123", result.ProxyBid.ProxyBidID)
+	assert.Equal(t, end.Add(-3*time.Second), result.BidPlacedAt)
+	assert.Equal(t, []time.Duration{time.Hour - 3*time.Second, 4 * time.Second, 10 * time.Second}, clock.waits)
+}
+
+func TestSnipeBidTooLow(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	end := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"errors": [{"errorId": %d}]}`, ebay.ErrGetBiddingNoBiddingActivity)
+	})
+	mux.HandleFunc("/buy/browse/v1/item/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"itemEndDate": "%s"}`, end.Format(time.RFC3339))
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"errors": [{"errorId": %d}]}`, ebay.ErrPlaceProxyBidAmountTooLow)
+	})
+
+	sniper := ebay.Sniper{
+		Offer:  client.Buy.Offer,
+		Bidder: client.Buy.Offer,
+		Browse: client.Buy.Browse,
+		Clock:  &fakeClock{now: end.Add(-time.Minute)},
+	}
+	result, err := sniper.Snipe(context.Background(), ebay.Snipe{
+		ItemID:        "v1|202117468662|0",
+		MarketplaceID: ebay.BuyMarketplaceUSA,
+		MaxAmount:     "1.00",
+		Currency:      "USD",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ebay.SnipeOutcomeBidTooLow, result.Outcome)
+	assert.True(t, ebay.IsError(result.Err, ebay.ErrPlaceProxyBidAmountTooLow))
+}
+
+func TestSnipeUnknownEndDate(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"errors": [{"errorId": %d}]}`, ebay.ErrGetBiddingNoBiddingActivity)
+	})
+	mux.HandleFunc("/buy/browse/v1/item/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("unexpected proxy bid")
+	})
+
+	clock := &fakeClock{now: time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)}
+	sniper := ebay.Sniper{Offer: client.Buy.Offer, Bidder: client.Buy.Offer, Browse: client.Buy.Browse, Clock: clock}
+	_, err := sniper.Snipe(context.Background(), ebay.Snipe{
+		ItemID:        "v1|202117468662|0",
+		MarketplaceID: ebay.BuyMarketplaceUSA,
+		MaxAmount:     "20.00",
+		Currency:      "USD",
+	})
+	assert.NotNil(t, err)
+	assert.Empty(t, clock.waits)
+}
+
+func TestSnipeAuctionOver(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	end := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"auctionStatus": "ACTIVE", "auctionEndDate": "%s"}`, end.Format(time.RFC3339))
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("unexpected proxy bid")
+	})
+
+	clock := &fakeClock{now: end.Add(time.Second)}
+	sniper := ebay.Sniper{Offer: client.Buy.Offer, Bidder: client.Buy.Offer, Clock: clock}
+	result, err := sniper.Snipe(context.Background(), ebay.Snipe{
+		ItemID:        "v1|202117468662|0",
+		MarketplaceID: ebay.BuyMarketplaceUSA,
+		MaxAmount:     "20.00",
+		Currency:      "USD",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, ebay.SnipeOutcomeAuctionEnded, result.Outcome)
+	assert.Empty(t, clock.waits)
+}
+
+func TestSnipeGuarded(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	end := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"auctionStatus": "ACTIVE", "auctionEndDate": "%s"}`, end.Format(time.RFC3339))
+	})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0/place_proxy_bid", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected proxy bid")
+	})
+
+	max, _ := ebay.ParseDecimal("10")
+	clock := &fakeClock{now: end.Add(-time.Minute)}
+	sniper := ebay.Sniper{
+		Offer:  client.Buy.Offer,
+		Bidder: &ebay.OfferGuard{Offer: client.Buy.Offer, MaxBid: map[string]ebay.Decimal{"USD": max}, Clock: clock},
+		Clock:  clock,
+	}
+	_, err := sniper.Snipe(context.Background(), ebay.Snipe{
+		ItemID:        "v1|202117468662|0",
+		MarketplaceID: ebay.BuyMarketplaceUSA,
+		MaxAmount:     "20.00",
+		Currency:      "USD",
+	})
+	assert.IsType(t, &ebay.GuardError{}, err)
+}
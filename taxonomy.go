@@ -0,0 +1,269 @@
+package ebay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TaxonomyService handles communication with the Taxonomy API.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/static/overview.html
+type TaxonomyService service
+
+// CategoryTreeID identifies a version of a category tree.
+type CategoryTreeID struct {
+	CategoryTreeID      string `json:"categoryTreeId"`
+	CategoryTreeVersion string `json:"categoryTreeVersion"`
+}
+
+// GetDefaultCategoryTreeID retrieves the ID of the category tree used by a marketplace.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/getDefaultCategoryTreeId
+func (s *TaxonomyService) GetDefaultCategoryTreeID(ctx context.Context, marketplaceID string, opts ...Opt) (CategoryTreeID, error) {
+	u := fmt.Sprintf("commerce/taxonomy/v1/get_default_category_tree_id?marketplace_id=%s", url.QueryEscape(marketplaceID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return CategoryTreeID{}, err
+	}
+	var id CategoryTreeID
+	return id, s.client.Do(ctx, req, &id)
+}
+
+// Category represents an eBay category.
+type Category struct {
+	CategoryID   string `json:"categoryId"`
+	CategoryName string `json:"categoryName"`
+}
+
+// CategoryTreeNode represents a category and its subcategories.
+type CategoryTreeNode struct {
+	Category                   Category           `json:"category"`
+	CategoryTreeNodeLevel      int                `json:"categoryTreeNodeLevel"`
+	ChildCategoryTreeNodes     []CategoryTreeNode `json:"childCategoryTreeNodes"`
+	LeafCategoryTreeNode       bool               `json:"leafCategoryTreeNode"`
+	ParentCategoryTreeNodeHref string             `json:"parentCategoryTreeNodeHref"`
+}
+
+// CategoryTree represents the category tree of one or more marketplaces.
+type CategoryTree struct {
+	ApplicableMarketplaceIDs []string         `json:"applicableMarketplaceIds"`
+	CategoryTreeID           string           `json:"categoryTreeId"`
+	CategoryTreeVersion      string           `json:"categoryTreeVersion"`
+	RootCategoryNode         CategoryTreeNode `json:"rootCategoryNode"`
+}
+
+// GetCategoryTree retrieves a complete category tree.
+// The response is large, consider saving it with WriteFile.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/getCategoryTree
+func (s *TaxonomyService) GetCategoryTree(ctx context.Context, categoryTreeID string, opts ...Opt) (CategoryTree, error) {
+	u := fmt.Sprintf("commerce/taxonomy/v1/category_tree/%s", categoryTreeID)
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return CategoryTree{}, err
+	}
+	var tree CategoryTree
+	return tree, s.client.Do(ctx, req, &tree)
+}
+
+// CategorySubtree represents a category of a category tree and its subcategories.
+type CategorySubtree struct {
+	CategorySubtreeNode CategoryTreeNode `json:"categorySubtreeNode"`
+	CategoryTreeID      string           `json:"categoryTreeId"`
+	CategoryTreeVersion string           `json:"categoryTreeVersion"`
+}
+
+// GetCategorySubtree retrieves a category of a category tree and its subcategories.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/getCategorySubtree
+func (s *TaxonomyService) GetCategorySubtree(ctx context.Context, categoryTreeID, categoryID string, opts ...Opt) (CategorySubtree, error) {
+	u := fmt.Sprintf("commerce/taxonomy/v1/category_tree/%s/get_category_subtree?category_id=%s", categoryTreeID, url.QueryEscape(categoryID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return CategorySubtree{}, err
+	}
+	var subtree CategorySubtree
+	return subtree, s.client.Do(ctx, req, &subtree)
+}
+
+// CategorySuggestion represents a leaf category suggested for a query.
+// CategoryTreeNodeAncestors lists the ancestors of the category, closest first.
+type CategorySuggestion struct {
+	Category                  Category `json:"category"`
+	CategoryTreeNodeAncestors []struct {
+		CategoryID              string `json:"categoryId"`
+		CategoryName            string `json:"categoryName"`
+		CategorySubtreeNodeHref string `json:"categorySubtreeNodeHref"`
+		CategoryTreeNodeLevel   int    `json:"categoryTreeNodeLevel"`
+	} `json:"categoryTreeNodeAncestors"`
+	CategoryTreeNodeLevel int    `json:"categoryTreeNodeLevel"`
+	Relevancy             string `json:"relevancy"`
+}
+
+// CategorySuggestions represents the categories suggested for a query.
+type CategorySuggestions struct {
+	CategorySuggestions []CategorySuggestion `json:"categorySuggestions"`
+	CategoryTreeID      string               `json:"categoryTreeId"`
+	CategoryTreeVersion string               `json:"categoryTreeVersion"`
+}
+
+// GetCategorySuggestions retrieves the leaf categories of a category tree that best match a query.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/getCategorySuggestions
+func (s *TaxonomyService) GetCategorySuggestions(ctx context.Context, categoryTreeID, q string, opts ...Opt) (CategorySuggestions, error) {
+	u := fmt.Sprintf("commerce/taxonomy/v1/category_tree/%s/get_category_suggestions?q=%s", categoryTreeID, url.QueryEscape(q))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return CategorySuggestions{}, err
+	}
+	var suggestions CategorySuggestions
+	return suggestions, s.client.Do(ctx, req, &suggestions)
+}
+
+// Valid values for the "aspectMode" aspect constraint field.
+const (
+	AspectModeFreeText      = "FREE_TEXT"
+	AspectModeSelectionOnly = "SELECTION_ONLY"
+)
+
+// Valid values for the "itemToAspectCardinality" aspect constraint field.
+const (
+	AspectCardinalitySingle = "SINGLE"
+	AspectCardinalityMulti  = "MULTI"
+)
+
+// CategoryAspect represents an aspect that can describe the items of a leaf category.
+type CategoryAspect struct {
+	LocalizedAspectName string `json:"localizedAspectName"`
+	AspectConstraint    struct {
+		AspectDataType             string    `json:"aspectDataType"`
+		AspectEnabledForVariations bool      `json:"aspectEnabledForVariations"`
+		AspectFormat               string    `json:"aspectFormat"`
+		AspectMaxLength            int       `json:"aspectMaxLength"`
+		AspectMode                 string    `json:"aspectMode"`
+		AspectRequired             bool      `json:"aspectRequired"`
+		AspectUsage                string    `json:"aspectUsage"`
+		ExpectedRequiredByDate     time.Time `json:"expectedRequiredByDate"`
+		ItemToAspectCardinality    string    `json:"itemToAspectCardinality"`
+	} `json:"aspectConstraint"`
+	AspectValues []struct {
+		LocalizedValue   string `json:"localizedValue"`
+		ValueConstraints []struct {
+			ApplicableForLocalizedAspectName   string   `json:"applicableForLocalizedAspectName"`
+			ApplicableForLocalizedAspectValues []string `json:"applicableForLocalizedAspectValues"`
+		} `json:"valueConstraints"`
+	} `json:"aspectValues"`
+}
+
+// GetItemAspectsForCategory retrieves the aspects that can describe the items of a leaf category.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/taxonomy/resources/category_tree/methods/getItemAspectsForCategory
+func (s *TaxonomyService) GetItemAspectsForCategory(ctx context.Context, categoryTreeID, categoryID string, opts ...Opt) ([]CategoryAspect, error) {
+	u := fmt.Sprintf("commerce/taxonomy/v1/category_tree/%s/get_item_aspects_for_category?category_id=%s", categoryTreeID, url.QueryEscape(categoryID))
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Aspects []CategoryAspect `json:"aspects"`
+	}
+	return resp.Aspects, s.client.Do(ctx, req, &resp)
+}
+
+// ReadCategoryTreeFile reads a category tree saved with CategoryTree.WriteFile.
+func ReadCategoryTreeFile(path string) (CategoryTree, error) {
+	var tree CategoryTree
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return tree, errors.WithStack(err)
+	}
+	return tree, errors.WithStack(json.Unmarshal(b, &tree))
+}
+
+// WriteFile saves the category tree as a JSON file. The file is replaced atomically.
+// CategoryTreeVersion can be compared with the version returned by GetDefaultCategoryTreeID
+// to know when the saved tree must be downloaded again.
+func (t CategoryTree) WriteFile(path string) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return writeFileAtomic(path, b)
+}
+
+// CategoryIndex allows to navigate a category tree in memory.
+type CategoryIndex struct {
+	nodes   map[string]*CategoryTreeNode
+	parents map[string]*CategoryTreeNode
+	order   []*CategoryTreeNode
+}
+
+// NewCategoryIndex indexes the nodes of a category tree.
+// The tree must not be modified while the index is used.
+func NewCategoryIndex(tree *CategoryTree) *CategoryIndex {
+	x := &CategoryIndex{
+		nodes:   map[string]*CategoryTreeNode{},
+		parents: map[string]*CategoryTreeNode{},
+	}
+	x.add(&tree.RootCategoryNode, nil)
+	return x
+}
+
+func (x *CategoryIndex) add(node, parent *CategoryTreeNode) {
+	id := node.Category.CategoryID
+	x.nodes[id] = node
+	if parent != nil {
+		x.parents[id] = parent
+	}
+	x.order = append(x.order, node)
+	for i := range node.ChildCategoryTreeNodes {
+		x.add(&node.ChildCategoryTreeNodes[i], node)
+	}
+}
+
+// Node returns the node of a category, or nil if the category is not part of the tree.
+func (x *CategoryIndex) Node(categoryID string) *CategoryTreeNode {
+	return x.nodes[categoryID]
+}
+
+// Path returns the categories from the root of the tree to a category, included.
+// It returns nil if the category is not part of the tree.
+func (x *CategoryIndex) Path(categoryID string) []Category {
+	node, ok := x.nodes[categoryID]
+	if !ok {
+		return nil
+	}
+	var path []Category
+	for ; node != nil; node = x.parents[node.Category.CategoryID] {
+		path = append([]Category{node.Category}, path...)
+	}
+	return path
+}
+
+// IsLeaf reports whether a category of the tree has no subcategory.
+// Items can only be listed in leaf categories.
+func (x *CategoryIndex) IsLeaf(categoryID string) bool {
+	node, ok := x.nodes[categoryID]
+	return ok && (node.LeafCategoryTreeNode || len(node.ChildCategoryTreeNodes) == 0)
+}
+
+// Search returns the nodes whose category name contains name, ignoring case,
+// in depth-first order.
+func (x *CategoryIndex) Search(name string) []*CategoryTreeNode {
+	name = strings.ToLower(name)
+	var nodes []*CategoryTreeNode
+	for _, node := range x.order {
+		if strings.Contains(strings.ToLower(node.Category.CategoryName), name) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,129 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+const categoryTreeJSON = `{"categoryTreeId": "0", "categoryTreeVersion": "119", "rootCategoryNode": {
+	"category": {"categoryId": "0", "categoryName": "Root"}, "categoryTreeNodeLevel": 0,
+	"childCategoryTreeNodes": [
+		{"category": {"categoryId": "11450", "categoryName": "Clothing, Shoes & Accessories"}, "categoryTreeNodeLevel": 1,
+			"childCategoryTreeNodes": [
+				{"category": {"categoryId": "57988", "categoryName": "Coats & Jackets"}, "categoryTreeNodeLevel": 2, "leafCategoryTreeNode": true},
+				{"category": {"categoryId": "15687", "categoryName": "T-Shirts"}, "categoryTreeNodeLevel": 2, "leafCategoryTreeNode": true}
+			]},
+		{"category": {"categoryId": "293", "categoryName": "Consumer Electronics"}, "categoryTreeNodeLevel": 1, "leafCategoryTreeNode": true}
+	]}}`
+
+func TestGetCategoryTree(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/taxonomy/v1/get_default_category_tree_id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.URL.Query().Get("marketplace_id"))
+		fmt.Fprint(w, `{"categoryTreeId": "0", "categoryTreeVersion": "119"}`)
+	})
+	mux.HandleFunc("/commerce/taxonomy/v1/category_tree/0", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Fatalf("expected GET method, got: %s", r.Method)
+		}
+		fmt.Fprint(w, categoryTreeJSON)
+	})
+
+	id, err := client.Commerce.Taxonomy.GetDefaultCategoryTreeID(context.Background(), ebay.BuyMarketplaceUSA)
+	assert.Nil(t, err)
+	assert.Equal(t, ebay.CategoryTreeID{CategoryTreeID: "0", CategoryTreeVersion: "119"}, id)
+
+	tree, err := client.Commerce.Taxonomy.GetCategoryTree(context.Background(), id.CategoryTreeID)
+	assert.Nil(t, err)
+	assert.Equal(t, "119", tree.CategoryTreeVersion)
+
+	dir, err := ioutil.TempDir("", "taxonomy")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tree.json")
+	assert.Nil(t, tree.WriteFile(path))
+	saved, err := ebay.ReadCategoryTreeFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, tree, saved)
+
+	x := ebay.NewCategoryIndex(&saved)
+	assert.Equal(t, "T-Shirts", x.Node("15687").Category.CategoryName)
+	assert.Nil(t, x.Node("1"))
+	assert.Equal(t, []ebay.Category{
+		{CategoryID: "0", CategoryName: "Root"},
+		{CategoryID: "11450", CategoryName: "Clothing, Shoes & Accessories"},
+		{CategoryID: "57988", CategoryName: "Coats & Jackets"},
+	}, x.Path("57988"))
+	assert.Nil(t, x.Path("1"))
+	assert.True(t, x.IsLeaf("293"))
+	assert.False(t, x.IsLeaf("11450"))
+	assert.False(t, x.IsLeaf("1"))
+	var names []string
+	for _, node := range x.Search("c") {
+		names = append(names, node.Category.CategoryName)
+	}
+	assert.Equal(t, []string{"Clothing, Shoes & Accessories", "Coats & Jackets", "Consumer Electronics"}, names)
+}
+
+func TestGetCategorySubtree(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/taxonomy/v1/category_tree/0/get_category_subtree", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "11450", r.URL.Query().Get("category_id"))
+		fmt.Fprint(w, `{"categoryTreeId": "0", "categorySubtreeNode": {"category": {"categoryId": "11450"},
+			"childCategoryTreeNodes": [{"category": {"categoryId": "15687"}, "leafCategoryTreeNode": true}]}}`)
+	})
+
+	subtree, err := client.Commerce.Taxonomy.GetCategorySubtree(context.Background(), "0", "11450")
+	assert.Nil(t, err)
+	assert.Equal(t, "15687", subtree.CategorySubtreeNode.ChildCategoryTreeNodes[0].Category.CategoryID)
+}
+
+func TestGetCategorySuggestions(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/taxonomy/v1/category_tree/0/get_category_suggestions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "red shirt", r.URL.Query().Get("q"))
+		fmt.Fprint(w, `{"categorySuggestions": [{"category": {"categoryId": "15687", "categoryName": "T-Shirts"},
+			"categoryTreeNodeAncestors": [{"categoryId": "11450", "categoryTreeNodeLevel": 1}]}]}`)
+	})
+
+	suggestions, err := client.Commerce.Taxonomy.GetCategorySuggestions(context.Background(), "0", "red shirt")
+	assert.Nil(t, err)
+	assert.Equal(t, "15687", suggestions.CategorySuggestions[0].Category.CategoryID)
+	assert.Equal(t, "11450", suggestions.CategorySuggestions[0].CategoryTreeNodeAncestors[0].CategoryID)
+}
+
+func TestGetItemAspectsForCategory(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/taxonomy/v1/category_tree/0/get_item_aspects_for_category", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "15687", r.URL.Query().Get("category_id"))
+		fmt.Fprint(w, `{"aspects": [{"localizedAspectName": "Color",
+			"aspectConstraint": {"aspectMode": "SELECTION_ONLY", "aspectRequired": true, "itemToAspectCardinality": "MULTI"},
+			"aspectValues": [{"localizedValue": "Red"}]}]}`)
+	})
+
+	aspects, err := client.Commerce.Taxonomy.GetItemAspectsForCategory(context.Background(), "0", "15687")
+	assert.Nil(t, err)
+	assert.Equal(t, "Color", aspects[0].LocalizedAspectName)
+	assert.Equal(t, ebay.AspectModeSelectionOnly, aspects[0].AspectConstraint.AspectMode)
+	assert.True(t, aspects[0].AspectConstraint.AspectRequired)
+	assert.Equal(t, "Red", aspects[0].AspectValues[0].LocalizedValue)
+}
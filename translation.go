@@ -0,0 +1,153 @@
+package ebay
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// TranslationService handles communication with the Translation API.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/translation/overview.html
+type TranslationService service
+
+// Valid values for the "from" and "to" translation fields.
+const (
+	TranslationLanguageChinese    = "zh"
+	TranslationLanguageDutch      = "nl"
+	TranslationLanguageEnglish    = "en"
+	TranslationLanguageFrench     = "fr"
+	TranslationLanguageGerman     = "de"
+	TranslationLanguageItalian    = "it"
+	TranslationLanguageJapanese   = "ja"
+	TranslationLanguagePolish     = "pl"
+	TranslationLanguagePortuguese = "pt"
+	TranslationLanguageRussian    = "ru"
+	TranslationLanguageSpanish    = "es"
+)
+
+// Valid values for the "translationContext" translation field.
+const (
+	TranslationContextItemTitle       = "ITEM_TITLE"
+	TranslationContextItemDescription = "ITEM_DESCRIPTION"
+)
+
+// TranslateRequest represents texts to translate.
+type TranslateRequest struct {
+	From               string   `json:"from"`
+	To                 string   `json:"to"`
+	Text               []string `json:"text"`
+	TranslationContext string   `json:"translationContext"`
+}
+
+// Translation represents a translated text.
+type Translation struct {
+	OriginalText   string `json:"originalText"`
+	TranslatedText string `json:"translatedText"`
+}
+
+// TranslateResponse represents the translations of texts, in the order of the request.
+type TranslateResponse struct {
+	From         string        `json:"from"`
+	To           string        `json:"to"`
+	Translations []Translation `json:"translations"`
+}
+
+// Translate translates texts from one language to another.
+//
+// eBay API docs: https://developer.ebay.com/api-docs/commerce/translation/resources/language/methods/translate
+func (s *TranslationService) Translate(ctx context.Context, tr TranslateRequest, opts ...Opt) (TranslateResponse, error) {
+	u := "commerce/translation/v1_beta/translate"
+	req, err := s.client.NewRequest(http.MethodPost, u, &tr, opts...)
+	if err != nil {
+		return TranslateResponse{}, err
+	}
+	var resp TranslateResponse
+	return resp, s.client.Do(ctx, req, &resp)
+}
+
+// translateItemConcurrency limits the number of concurrent Translate calls made by TranslateItem.
+const translateItemConcurrency = 4
+
+// TranslateItem returns a copy of an item whose title, short description and localized
+// aspect names and values are translated. The short description uses the ITEM_DESCRIPTION context
+// and the title and aspects, which are short texts, the ITEM_TITLE context.
+//
+// Translate currently accepts a single text per call: TranslateItem makes one call per distinct text,
+// up to 2 per aspect, with at most 4 calls in flight. Each call counts against the Translation API quota.
+func (s *TranslationService) TranslateItem(ctx context.Context, it Item, from, to string, opts ...Opt) (Item, error) {
+	type key struct{ text, translationContext string }
+	var keys []key
+	index := map[key]int{}
+	add := func(text, translationContext string) {
+		k := key{text, translationContext}
+		if _, ok := index[k]; ok || text == "" {
+			return
+		}
+		index[k] = len(keys)
+		keys = append(keys, k)
+	}
+	add(it.Title, TranslationContextItemTitle)
+	add(it.ShortDescription, TranslationContextItemDescription)
+	for _, a := range it.LocalizedAspects {
+		add(a.Name, TranslationContextItemTitle)
+		add(a.Value, TranslationContextItemTitle)
+	}
+
+	// The first error cancels the calls not yet made.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	translated := make([]string, len(keys))
+	var firstErr error
+	var once sync.Once
+	sem := make(chan struct{}, translateItemConcurrency)
+	var wg sync.WaitGroup
+	for i, k := range keys {
+		wg.Add(1)
+		go func(i int, k key) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			resp, err := s.Translate(ctx, TranslateRequest{
+				From:               from,
+				To:                 to,
+				Text:               []string{k.text},
+				TranslationContext: k.translationContext,
+			}, opts...)
+			if err == nil && len(resp.Translations) != 1 {
+				err = errors.Errorf("expected 1 translation, got %d", len(resp.Translations))
+			}
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			translated[i] = resp.Translations[0].TranslatedText
+		}(i, k)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return Item{}, firstErr
+	}
+
+	translate := func(text, translationContext string) string {
+		if i, ok := index[key{text, translationContext}]; ok {
+			return translated[i]
+		}
+		return text
+	}
+	it.Title = translate(it.Title, TranslationContextItemTitle)
+	it.ShortDescription = translate(it.ShortDescription, TranslationContextItemDescription)
+	aspects := it.LocalizedAspects
+	it.LocalizedAspects = append(aspects[:0:0], aspects...)
+	for i := range it.LocalizedAspects {
+		a := &it.LocalizedAspects[i]
+		a.Name = translate(a.Name, TranslationContextItemTitle)
+		a.Value = translate(a.Value, TranslationContextItemTitle)
+	}
+	return it, nil
+}
@@ -0,0 +1,113 @@
+package ebay_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateItem(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	var mu sync.Mutex
+	contexts := map[string]string{}
+	mux.HandleFunc("/commerce/translation/v1_beta/translate", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST method, got: %s", r.Method)
+			return
+		}
+		var tr ebay.TranslateRequest
+		if err := json.NewDecoder(r.Body).Decode(&tr); err != nil {
+			t.Errorf("%+v", err)
+			return
+		}
+		assert.Equal(t, ebay.TranslationLanguageGerman, tr.From)
+		assert.Equal(t, ebay.TranslationLanguageEnglish, tr.To)
+		// The API translates a single text per call.
+		if len(tr.Text) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		_, dup := contexts[tr.Text[0]]
+		contexts[tr.Text[0]] = tr.TranslationContext
+		mu.Unlock()
+		assert.False(t, dup, tr.Text[0])
+		_ = json.NewEncoder(w).Encode(ebay.TranslateResponse{From: tr.From, To: tr.To, Translations: []ebay.Translation{
+			{OriginalText: tr.Text[0], TranslatedText: strings.ToUpper(tr.Text[0])},
+		}})
+	})
+
+	var it ebay.Item
+	if err := json.Unmarshal([]byte(`{"title": "Rotes Hemd", "shortDescription": "Ein Hemd",
+		"localizedAspects": [{"name": "Farbe", "value": "Rot"}, {"name": "Farbe", "value": "Rot"}]}`), &it); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	translated, err := client.Commerce.Translation.TranslateItem(context.Background(), it, ebay.TranslationLanguageGerman, ebay.TranslationLanguageEnglish)
+	assert.Nil(t, err)
+	assert.Equal(t, "ROTES HEMD", translated.Title)
+	assert.Equal(t, "EIN HEMD", translated.ShortDescription)
+	assert.Equal(t, "FARBE", translated.LocalizedAspects[1].Name)
+	assert.Equal(t, "ROT", translated.LocalizedAspects[1].Value)
+	assert.Equal(t, "Farbe", it.LocalizedAspects[1].Name)
+	assert.Equal(t, map[string]string{
+		"Rotes Hemd": ebay.TranslationContextItemTitle,
+		"Ein Hemd":   ebay.TranslationContextItemDescription,
+		"Farbe":      ebay.TranslationContextItemTitle,
+		"Rot":        ebay.TranslationContextItemTitle,
+	}, contexts)
+}
+
+func TestTranslateItemConcurrency(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	var mu sync.Mutex
+	inFlight, maxInFlight, calls := 0, 0, 0
+	mux.HandleFunc("/commerce/translation/v1_beta/translate", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		calls++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		var tr ebay.TranslateRequest
+		_ = json.NewDecoder(r.Body).Decode(&tr)
+		_ = json.NewEncoder(w).Encode(ebay.TranslateResponse{Translations: []ebay.Translation{{TranslatedText: tr.Text[0]}}})
+	})
+
+	it := ebay.Item{Title: "Hemd"}
+	for i := 0; i < 10; i++ {
+		it.LocalizedAspects = append(it.LocalizedAspects, ebay.LocalizedAspect{Name: fmt.Sprint("name", i), Value: fmt.Sprint("value", i)})
+	}
+	_, err := client.Commerce.Translation.TranslateItem(context.Background(), it, "de", "en")
+	assert.Nil(t, err)
+	assert.Equal(t, 21, calls)
+	assert.True(t, maxInFlight <= 4, "%d calls in flight", maxInFlight)
+}
+
+func TestTranslateItemMismatch(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	mux.HandleFunc("/commerce/translation/v1_beta/translate", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"translations": []}`)
+	})
+
+	_, err := client.Commerce.Translation.TranslateItem(context.Background(), ebay.Item{Title: "Hemd"}, "de", "en")
+	assert.NotNil(t, err)
+}
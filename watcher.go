@@ -0,0 +1,247 @@
+package ebay
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// BiddingEventType describes the kind of change detected by a BiddingWatcher.
+type BiddingEventType string
+
+// Valid values for BiddingEventType.
+const (
+	BiddingEventOutbid     BiddingEventType = "OUTBID"
+	BiddingEventHighBidder BiddingEventType = "HIGH_BIDDER"
+	BiddingEventReserveMet BiddingEventType = "RESERVE_MET"
+	BiddingEventWon        BiddingEventType = "WON"
+	BiddingEventLost       BiddingEventType = "LOST"
+)
+
+// BiddingEvent represents a change in the buyer's bidding on an auction.
+type BiddingEvent struct {
+	Type          BiddingEventType
+	ItemID        string
+	MarketplaceID string
+	Bidding       Bidding
+}
+
+// DefaultBiddingPollInterval returns how long to wait before polling an auction again
+// given the time remaining before its end. Auctions are polled more often as they near their end.
+func DefaultBiddingPollInterval(remaining time.Duration) time.Duration {
+	switch {
+	case remaining > time.Hour:
+		return 10 * time.Minute
+	case remaining > 10*time.Minute:
+		return time.Minute
+	case remaining > time.Minute:
+		return 10 * time.Second
+	case remaining > 0:
+		return 2 * time.Second
+	}
+	return 10 * time.Second
+}
+
+type watchedAuction struct {
+	marketplaceID string
+	nextPoll      time.Time
+	known         bool // Whether the auction was polled successfully at least once.
+	last          Bidding
+	end           time.Time // Zero if unknown.
+}
+
+// BiddingWatcher polls the buyer's bidding on a set of auctions and reports the changes.
+// The zero value is ready to use once Offer is set.
+type BiddingWatcher struct {
+	// Offer retrieves the bidding details, usually the OfferService.
+	Offer BiddingGetter
+
+	// Browse, if set, is used to retrieve the end date of the auctions
+	// the buyer has no bidding activity on yet.
+	Browse *BrowseService
+
+	// Clock defaults to the system clock.
+	Clock Clock
+
+	// PollInterval defaults to DefaultBiddingPollInterval.
+	// remaining is the maximum duration if the end date of the auction is unknown.
+	PollInterval func(remaining time.Duration) time.Duration
+
+	// ErrorHandler, if set, is called with the errors encountered by Run
+	// instead of stopping the watch.
+	ErrorHandler func(itemID string, err error)
+
+	mu       sync.Mutex
+	auctions map[string]*watchedAuction
+	wake     chan struct{}
+}
+
+func (w *BiddingWatcher) init() {
+	if w.auctions == nil {
+		w.auctions = map[string]*watchedAuction{}
+		w.wake = make(chan struct{}, 1)
+	}
+}
+
+// Watch adds an auction to the watched auctions. It is polled as soon as possible.
+func (w *BiddingWatcher) Watch(itemID, marketplaceID string) {
+	w.mu.Lock()
+	w.init()
+	w.auctions[itemID] = &watchedAuction{marketplaceID: marketplaceID}
+	wake := w.wake
+	w.mu.Unlock()
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// Unwatch removes an auction from the watched auctions.
+func (w *BiddingWatcher) Unwatch(itemID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.auctions, itemID)
+}
+
+// Run polls the watched auctions and sends the changes on events until ctx is done.
+// Ended auctions are reported as won or lost and are no longer watched.
+// Auctions the buyer has no bidding activity on yet are polled without reporting any event.
+func (w *BiddingWatcher) Run(ctx context.Context, events chan<- BiddingEvent) error {
+	clock := clockOrSystem(w.Clock)
+	for {
+		w.mu.Lock()
+		w.init()
+		var itemID string
+		var next time.Time
+		for id, a := range w.auctions {
+			if itemID == "" || a.nextPoll.Before(next) {
+				itemID, next = id, a.nextPoll
+			}
+		}
+		wake := w.wake
+		w.mu.Unlock()
+
+		if itemID == "" {
+			select {
+			case <-wake:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if d := next.Sub(clock.Now()); d > 0 {
+			c, stop := after(clock, d)
+			select {
+			case <-c:
+			case <-wake:
+				stop()
+				continue
+			case <-ctx.Done():
+				stop()
+				return ctx.Err()
+			}
+		}
+		if err := w.poll(ctx, clock, itemID, events); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if w.ErrorHandler == nil {
+				return err
+			}
+			w.ErrorHandler(itemID, err)
+		}
+	}
+}
+
+func (w *BiddingWatcher) poll(ctx context.Context, clock Clock, itemID string, events chan<- BiddingEvent) error {
+	w.mu.Lock()
+	a, ok := w.auctions[itemID]
+	if !ok {
+		w.mu.Unlock()
+		return nil
+	}
+	marketplaceID := a.marketplaceID
+	prev, known, end := a.last, a.known, a.end
+	w.mu.Unlock()
+
+	pollInterval := w.PollInterval
+	if pollInterval == nil {
+		pollInterval = DefaultBiddingPollInterval
+	}
+
+	bidding, err := w.Offer.GetBidding(ctx, itemID, marketplaceID)
+	if err != nil {
+		noActivity := IsError(err, ErrGetBiddingNoBiddingActivity)
+		if noActivity && end.IsZero() && w.Browse != nil {
+			it, browseErr := w.Browse.GetItem(ctx, itemID, OptBuyMarketplace(marketplaceID))
+			if browseErr != nil {
+				err, noActivity = browseErr, false
+			}
+			end = it.ItemEndDate
+		}
+		remaining := time.Duration(math.MaxInt64)
+		if !end.IsZero() {
+			remaining = end.Sub(clock.Now())
+		} else if !noActivity {
+			// Retry failed polls of auctions with an unknown end date sooner than the longest interval.
+			remaining = 0
+		}
+		w.reschedule(itemID, a, clock.Now().Add(pollInterval(remaining)), nil, end)
+		if noActivity {
+			return nil
+		}
+		return err
+	}
+
+	var evts []BiddingEventType
+	switch {
+	case !prev.HighBidder && bidding.HighBidder:
+		evts = append(evts, BiddingEventHighBidder)
+	case prev.HighBidder && !bidding.HighBidder,
+		!known && !bidding.HighBidder && bidding.CurrentProxyBid.ProxyBidID != "":
+		evts = append(evts, BiddingEventOutbid)
+	}
+	if !prev.ReservePriceMet && bidding.ReservePriceMet {
+		evts = append(evts, BiddingEventReserveMet)
+	}
+	ended := bidding.AuctionStatus == BiddingAuctionStatusEnded
+	if ended {
+		evts = []BiddingEventType{BiddingEventLost}
+		if bidding.HighBidder {
+			evts[0] = BiddingEventWon
+		}
+		w.mu.Lock()
+		if w.auctions[itemID] == a {
+			delete(w.auctions, itemID)
+		}
+		w.mu.Unlock()
+	} else {
+		remaining := bidding.AuctionEndDate.Sub(clock.Now())
+		w.reschedule(itemID, a, clock.Now().Add(pollInterval(remaining)), &bidding, bidding.AuctionEndDate)
+	}
+
+	for _, t := range evts {
+		select {
+		case events <- BiddingEvent{Type: t, ItemID: itemID, MarketplaceID: marketplaceID, Bidding: bidding}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// reschedule updates an auction unless it was unwatched or replaced in the meantime.
+func (w *BiddingWatcher) reschedule(itemID string, a *watchedAuction, next time.Time, bidding *Bidding, end time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.auctions[itemID] != a {
+		return
+	}
+	a.nextPoll = next
+	a.end = end
+	if bidding != nil {
+		a.last = *bidding
+		a.known = true
+	}
+}
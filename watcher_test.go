@@ -0,0 +1,111 @@
+package ebay_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jybp/ebay"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiddingWatcher(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	start := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour).Format(time.RFC3339)
+	responses := []string{
+		"",
+		fmt.Sprintf(`{"auctionEndDate": "%s", "highBidder": true}`, end),
+		fmt.Sprintf(`{"auctionEndDate": "%s", "highBidder": false}`, end),
+		fmt.Sprintf(`{"auctionEndDate": "%s", "highBidder": true, "reservePriceMet": true}`, end),
+		fmt.Sprintf(`{"auctionEndDate": "%s", "highBidder": true, "reservePriceMet": true, "auctionStatus": "ENDED"}`, end),
+	}
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, ebay.BuyMarketplaceUSA, r.Header.Get("X-EBAY-C-MARKETPLACE-ID"))
+		resp := responses[0]
+		responses = responses[1:]
+		if resp == "" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"errors": [{"errorId": %d}]}`, ebay.ErrGetBiddingNoBiddingActivity)
+			return
+		}
+		fmt.Fprint(w, resp)
+	})
+
+	clock := &fakeClock{now: start}
+	watcher := &ebay.BiddingWatcher{Offer: client.Buy.Offer, Clock: clock}
+	watcher.Watch("v1|202117468662|0", ebay.BuyMarketplaceUSA)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan ebay.BiddingEvent)
+	done := make(chan error)
+	go func() { done <- watcher.Run(ctx, events) }()
+
+	var types []ebay.BiddingEventType
+	for e := range events {
+		assert.Equal(t, "v1|202117468662|0", e.ItemID)
+		types = append(types, e.Type)
+		if e.Type == ebay.BiddingEventWon {
+			break
+		}
+	}
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+	assert.Equal(t, []ebay.BiddingEventType{
+		ebay.BiddingEventHighBidder,
+		ebay.BiddingEventOutbid,
+		ebay.BiddingEventHighBidder,
+		ebay.BiddingEventReserveMet,
+		ebay.BiddingEventWon,
+	}, types)
+	// The end date is unknown until the first bid, the longest interval is used.
+	assert.Equal(t, []time.Duration{10 * time.Minute, 10 * time.Minute, 10 * time.Minute, 10 * time.Minute}, clock.waits)
+}
+
+func TestBiddingWatcherNoBiddingActivity(t *testing.T) {
+	client, mux, teardown := setup(t)
+	defer teardown()
+
+	start := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	polls := make(chan struct{})
+	mux.HandleFunc("/buy/offer/v1_beta/bidding/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"errors": [{"errorId": %d}]}`, ebay.ErrGetBiddingNoBiddingActivity)
+		polls <- struct{}{}
+	})
+	browsed := 0
+	mux.HandleFunc("/buy/browse/v1/item/v1|202117468662|0", func(w http.ResponseWriter, r *http.Request) {
+		browsed++
+		fmt.Fprintf(w, `{"itemEndDate": "%s"}`, start.Add(30*time.Minute).Format(time.RFC3339))
+	})
+
+	clock := &fakeClock{now: start}
+	watcher := &ebay.BiddingWatcher{Offer: client.Buy.Offer, Browse: client.Buy.Browse, Clock: clock}
+	watcher.Watch("v1|202117468662|0", ebay.BuyMarketplaceUSA)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- watcher.Run(ctx, make(chan ebay.BiddingEvent)) }()
+	for i := 0; i < 3; i++ {
+		<-polls
+	}
+	cancel()
+	go func() {
+		for range polls {
+		}
+	}()
+	assert.Equal(t, context.Canceled, <-done)
+	assert.Equal(t, 1, browsed)
+	assert.Equal(t, []time.Duration{time.Minute, time.Minute}, clock.waits[:2])
+}
+
+func TestDefaultBiddingPollInterval(t *testing.T) {
+	assert.Equal(t, 10*time.Minute, ebay.DefaultBiddingPollInterval(2*time.Hour))
+	assert.Equal(t, time.Minute, ebay.DefaultBiddingPollInterval(30*time.Minute))
+	assert.Equal(t, 10*time.Second, ebay.DefaultBiddingPollInterval(5*time.Minute))
+	assert.Equal(t, 2*time.Second, ebay.DefaultBiddingPollInterval(30*time.Second))
+}